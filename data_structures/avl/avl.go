@@ -0,0 +1,94 @@
+// Package avl implements the rotations that keep AVL trees balanced, shared by the trees of this module. Each
+// tree keeps its own node type and describes it to a Balancer through hooks, including one to maintain whatever
+// else the nodes summarize about their subtrees, such as an aggregate or the greatest bound of some intervals.
+package avl
+
+// Balancer rebalances AVL trees whose nodes are of type T. A nil node is an empty subtree.
+type Balancer[T any] struct {
+	// Children returns the addresses of the links to the left and right children of the node
+	Children func(node *T) (left **T, right **T)
+	// Height returns the address of the field where the node keeps the height of its subtree
+	Height func(node *T) *int
+	// Augment, if set, recomputes from the children anything else the node summarizes about its subtree. When
+	// it is called, the heights of the node and of its children are up to date.
+	Augment func(node *T)
+	// Copy, if set, returns a copy of the node. The rotations then modify copies of the nodes they move, which
+	// lets persistent trees leave untouched the nodes they share with older versions.
+	Copy func(node *T) *T
+}
+
+// HeightOf returns the height of the subtree rooted at the node, which is 0 if it is empty.
+func (balancer Balancer[T]) HeightOf(node *T) int {
+	if node == nil {
+		return 0
+	}
+	return *balancer.Height(node)
+}
+
+// Update recomputes the height of the node, and anything Augment maintains, from its children.
+func (balancer Balancer[T]) Update(node *T) {
+	left, right := balancer.Children(node)
+	*balancer.Height(node) = 1 + max(balancer.HeightOf(*left), balancer.HeightOf(*right))
+	if balancer.Augment != nil {
+		balancer.Augment(node)
+	}
+}
+
+func (balancer Balancer[T]) balanceFactor(node *T) int {
+	left, right := balancer.Children(node)
+	return balancer.HeightOf(*left) - balancer.HeightOf(*right)
+}
+
+// own returns the node the rotations may modify: the node itself, or a copy if the tree is persistent.
+func (balancer Balancer[T]) own(node *T) *T {
+	if balancer.Copy == nil {
+		return node
+	}
+	return balancer.Copy(node)
+}
+
+// rotateRight lifts left, the left child of the node, over it. Both must be owned by the caller.
+func (balancer Balancer[T]) rotateRight(node *T, left *T) *T {
+	nodeLeft, _ := balancer.Children(node)
+	_, leftRight := balancer.Children(left)
+	*nodeLeft, *leftRight = *leftRight, node
+	balancer.Update(node)
+	balancer.Update(left)
+	return left
+}
+
+// rotateLeft lifts right, the right child of the node, over it. Both must be owned by the caller.
+func (balancer Balancer[T]) rotateLeft(node *T, right *T) *T {
+	_, nodeRight := balancer.Children(node)
+	rightLeft, _ := balancer.Children(right)
+	*nodeRight, *rightLeft = *rightLeft, node
+	balancer.Update(node)
+	balancer.Update(right)
+	return right
+}
+
+// Rebalance updates the node, whose subtrees must be balanced and differ in height by at most 2, and applies
+// the rotations needed to restore the AVL property. It returns the new root of the subtree. The node itself is
+// modified even if the tree is persistent, so it must be a new one in that case.
+func (balancer Balancer[T]) Rebalance(node *T) *T {
+	balancer.Update(node)
+	left, right := balancer.Children(node)
+	balance := balancer.balanceFactor(node)
+	if balance > 1 {
+		child := balancer.own(*left)
+		if balancer.balanceFactor(child) < 0 {
+			_, grandchild := balancer.Children(child)
+			child = balancer.rotateLeft(child, balancer.own(*grandchild))
+		}
+		return balancer.rotateRight(node, child)
+	}
+	if balance < -1 {
+		child := balancer.own(*right)
+		if balancer.balanceFactor(child) > 0 {
+			grandchild, _ := balancer.Children(child)
+			child = balancer.rotateRight(child, balancer.own(*grandchild))
+		}
+		return balancer.rotateLeft(node, child)
+	}
+	return node
+}
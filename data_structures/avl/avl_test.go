@@ -0,0 +1,111 @@
+package avl_test
+
+import (
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/avl"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_KEYS     = 1000
+	_VERSIONS = 200
+)
+
+type node struct {
+	left   *node
+	right  *node
+	key    int
+	size   int
+	height int
+}
+
+func balancer(persistent bool) avl.Balancer[node] {
+	balancer := avl.Balancer[node]{
+		Children: func(n *node) (**node, **node) { return &n.left, &n.right },
+		Height:   func(n *node) *int { return &n.height },
+		Augment: func(n *node) {
+			n.size = 1
+			for _, child := range []*node{n.left, n.right} {
+				if child != nil {
+					n.size += child.size
+				}
+			}
+		},
+	}
+	if persistent {
+		balancer.Copy = func(n *node) *node {
+			copied := *n
+			return &copied
+		}
+	}
+	return balancer
+}
+
+// insert adds the key to the subtree, copying the path to it if the balancer is persistent.
+func insert(balancer avl.Balancer[node], root *node, key int) *node {
+	if root == nil {
+		leaf := &node{key: key}
+		balancer.Update(leaf)
+		return leaf
+	}
+	copied := *root
+	if key < root.key {
+		copied.left = insert(balancer, root.left, key)
+	} else {
+		copied.right = insert(balancer, root.right, key)
+	}
+	return balancer.Rebalance(&copied)
+}
+
+// removeMin removes the lowest key of the subtree, copying the path to it.
+func removeMin(balancer avl.Balancer[node], root *node) *node {
+	if root.left == nil {
+		return root.right
+	}
+	copied := *root
+	copied.left = removeMin(balancer, root.left)
+	return balancer.Rebalance(&copied)
+}
+
+// requireBalanced checks the heights, sizes and order of the subtree, and returns its keys in order.
+func requireBalanced(t *testing.T, balancer avl.Balancer[node], root *node) []int {
+	if root == nil {
+		return nil
+	}
+	left, right := requireBalanced(t, balancer, root.left), requireBalanced(t, balancer, root.right)
+	leftHeight, rightHeight := balancer.HeightOf(root.left), balancer.HeightOf(root.right)
+	require.LessOrEqual(t, max(leftHeight-rightHeight, rightHeight-leftHeight), 1)
+	require.Equal(t, 1+max(leftHeight, rightHeight), root.height)
+	require.Equal(t, 1+len(left)+len(right), root.size, "Augment should be called on every rotated node")
+	return append(append(left, root.key), right...)
+}
+
+func TestBalancerKeepsSortedInsertionsBalanced(t *testing.T) {
+	balancer := balancer(false)
+	var root *node
+	for key := 0; key < _KEYS; key++ {
+		root = insert(balancer, root, key)
+	}
+	keys := requireBalanced(t, balancer, root)
+	for i, key := range keys {
+		require.Equal(t, i, key)
+	}
+	require.LessOrEqual(t, root.height, 15, "An AVL tree of 1000 nodes has a height of at most 1.44 log n")
+}
+
+func TestBalancerWithCopyLeavesOldVersionsUntouched(t *testing.T) {
+	balancer := balancer(true)
+	versions := []*node{nil}
+	for key := 0; key < _VERSIONS; key++ {
+		versions = append(versions, insert(balancer, versions[len(versions)-1], key))
+	}
+	// Removing the lowest keys unbalances the nodes towards their right subtrees, which are shared
+	for key := 0; key < _VERSIONS; key++ {
+		versions = append(versions, removeMin(balancer, versions[len(versions)-1]))
+	}
+	for i, version := range versions {
+		require.Len(t, requireBalanced(t, balancer, version), min(i, 2*_VERSIONS-i), "Old versions should not change")
+	}
+}
@@ -0,0 +1,64 @@
+package cache
+
+import "time"
+
+type Cache[K comparable, V any] interface {
+	// Put stores the value under the key, marking it as just used. If the key is already cached, its value is
+	// updated and its time to live restarts. If the cache is full, an entry is evicted first, chosen by the
+	// cache policy.
+	Put(key K, value V)
+
+	// Get returns the value cached under the key, marking it as just used. The last result is false if the
+	// key is not cached or has expired. Every call counts as a hit or a miss in the statistics.
+	Get(key K) (V, bool)
+
+	// Contains returns true if the key is cached and has not expired, without marking it as used.
+	Contains(key K) bool
+
+	// Remove removes the key from the cache and returns its value. If the key is not cached or has expired,
+	// it panics with the message "The key does not belong to the cache".
+	Remove(key K) V
+
+	// RemoveExpired removes every expired entry and returns how many were removed.
+	RemoveExpired() int
+
+	// Count returns the number of entries in the cache. Expired entries are removed lazily, so they are
+	// counted until they are accessed, evicted or removed with RemoveExpired.
+	Count() int
+
+	// Capacity returns the maximum number of entries the cache can hold.
+	Capacity() int
+
+	// Stats returns the statistics of the cache since it was created.
+	Stats() Stats
+}
+
+// Stats counts the lookups done with Get and the entries the cache dropped by itself.
+type Stats struct {
+	Hits        int
+	Misses      int
+	Evictions   int
+	Expirations int
+}
+
+// HitRatio returns the fraction of lookups that were hits, or 0 if there were none.
+func (stats Stats) HitRatio() float64 {
+	if stats.Hits+stats.Misses == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(stats.Hits+stats.Misses)
+}
+
+// Options configures the optional behaviour of a cache. The zero value means entries never expire and
+// nothing is notified.
+type Options[K comparable, V any] struct {
+	// TTL is how long an entry lives after it was last stored. Zero means entries never expire.
+	TTL time.Duration
+
+	// OnEvict is called with every entry the cache drops by itself, either to make room or because it
+	// expired. It is not called for entries removed with Remove or overwritten with Put.
+	OnEvict func(key K, value V)
+
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+}
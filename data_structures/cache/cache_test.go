@@ -0,0 +1,169 @@
+package cache_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	ADTCache "github.com/sebagarciad/algorithms-and-data-structures/cache"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock that only moves when told to.
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) Advance(duration time.Duration) {
+	clock.now = clock.now.Add(duration)
+}
+
+func cacheFactories() map[string]func(int, ADTCache.Options[string, int]) ADTCache.Cache[string, int] {
+	return map[string]func(int, ADTCache.Options[string, int]) ADTCache.Cache[string, int]{
+		"LRU": ADTCache.NewLRUCacheWithOptions[string, int],
+		"LFU": ADTCache.NewLFUCacheWithOptions[string, int],
+	}
+}
+
+func TestCacheInvalidOptions(t *testing.T) {
+	require.PanicsWithValue(t, "The capacity of the cache must be positive",
+		func() { ADTCache.NewLRUCache[string, int](0) })
+	require.PanicsWithValue(t, "The time to live of the entries cannot be negative",
+		func() { ADTCache.NewLFUCacheWithOptions(1, ADTCache.Options[string, int]{TTL: -time.Second}) })
+}
+
+func TestCacheBasics(t *testing.T) {
+	for name, factory := range cacheFactories() {
+		t.Run(name, func(t *testing.T) {
+			cache := factory(2, ADTCache.Options[string, int]{})
+			require.EqualValues(t, 2, cache.Capacity())
+			_, found := cache.Get("a")
+			require.False(t, found)
+			require.PanicsWithValue(t, "The key does not belong to the cache", func() { cache.Remove("a") })
+
+			cache.Put("a", 1)
+			cache.Put("b", 2)
+			cache.Put("a", 10)
+			require.EqualValues(t, 2, cache.Count())
+			value, found := cache.Get("a")
+			require.True(t, found)
+			require.Equal(t, 10, value)
+			require.True(t, cache.Contains("b"))
+
+			require.Equal(t, 2, cache.Remove("b"))
+			require.False(t, cache.Contains("b"))
+			require.EqualValues(t, 1, cache.Count())
+			require.Equal(t, ADTCache.Stats{Hits: 1, Misses: 1}, cache.Stats())
+			require.Equal(t, 0.5, cache.Stats().HitRatio())
+		})
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	evicted := []string{}
+	cache := ADTCache.NewLRUCacheWithOptions(3, ADTCache.Options[string, int]{
+		OnEvict: func(key string, _ int) { evicted = append(evicted, key) },
+	})
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Put("d", 4)
+	require.Equal(t, []string{"b"}, evicted, "The least recently used entry should be evicted")
+
+	cache.Contains("c")
+	cache.Put("e", 5)
+	require.Equal(t, []string{"b", "c"}, evicted, "Contains should not mark the entry as used")
+	require.True(t, cache.Contains("a"))
+	require.EqualValues(t, 2, cache.Stats().Evictions)
+}
+
+func TestLFUCacheEviction(t *testing.T) {
+	evicted := []string{}
+	cache := ADTCache.NewLFUCacheWithOptions(3, ADTCache.Options[string, int]{
+		OnEvict: func(key string, _ int) { evicted = append(evicted, key) },
+	})
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+	cache.Put("d", 4)
+	require.Equal(t, []string{"c"}, evicted, "The least frequently used entry should be evicted")
+
+	cache.Put("e", 5)
+	require.Equal(t, []string{"c", "d"}, evicted)
+
+	cache.Get("e")
+	cache.Put("f", 6)
+	require.Equal(t, []string{"c", "d", "b"}, evicted, "Ties should be broken by recency")
+	require.True(t, cache.Contains("a"))
+	require.True(t, cache.Contains("e"))
+}
+
+func TestCacheExpiration(t *testing.T) {
+	for name, factory := range cacheFactories() {
+		t.Run(name, func(t *testing.T) {
+			clock := &fakeClock{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+			expired := []string{}
+			cache := factory(10, ADTCache.Options[string, int]{
+				TTL:     time.Minute,
+				Now:     clock.Now,
+				OnEvict: func(key string, _ int) { expired = append(expired, key) },
+			})
+			cache.Put("a", 1)
+			clock.Advance(30 * time.Second)
+			cache.Put("b", 2)
+			cache.Put("c", 3)
+
+			clock.Advance(30 * time.Second)
+			_, found := cache.Get("a")
+			require.False(t, found, "The entry should expire after its time to live")
+			require.True(t, cache.Contains("b"))
+			require.PanicsWithValue(t, "The key does not belong to the cache", func() { cache.Remove("a") })
+
+			cache.Put("b", 20)
+			clock.Advance(30 * time.Second)
+			require.EqualValues(t, 2, cache.Count(), "Expired entries are only removed when accessed")
+			require.EqualValues(t, 1, cache.RemoveExpired())
+			require.True(t, cache.Contains("b"), "Storing a value again should restart its time to live")
+
+			require.Equal(t, []string{"a", "c"}, expired)
+			require.Equal(t, ADTCache.Stats{Misses: 1, Expirations: 2}, cache.Stats())
+		})
+	}
+}
+
+func TestCacheVolume(t *testing.T) {
+	for name, factory := range cacheFactories() {
+		t.Run(name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(11))
+			capacity := 100
+			cache := factory(capacity, ADTCache.Options[string, int]{})
+			stored := make(map[string]int)
+
+			for i := 0; i < 50000; i++ {
+				key := fmt.Sprintf("key%d", rng.Intn(300))
+				if rng.Intn(3) == 0 {
+					cache.Put(key, i)
+					stored[key] = i
+				} else if value, found := cache.Get(key); found {
+					require.Equal(t, stored[key], value, "A cached value should be the last one stored")
+				}
+				require.LessOrEqual(t, cache.Count(), capacity)
+			}
+
+			stats := cache.Stats()
+			require.EqualValues(t, capacity, cache.Count())
+			require.Greater(t, stats.Hits, 0)
+			require.Greater(t, stats.Evictions, 0)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"time"
+
+	ADTList "github.com/sebagarciad/algorithms-and-data-structures/linked_list"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+)
+
+// ===================== Types ==========================
+
+// frequencyBucket groups the entries used the same number of times, from the most to the least recently used.
+type frequencyBucket[K comparable, V any] struct {
+	frequency int
+	entries   ADTList.DoublyLinkedList[*lfuEntry[K, V]]
+}
+
+type lfuEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	expiration time.Time
+	bucket     *ADTList.Node[*frequencyBucket[K, V]]
+	node       *ADTList.Node[*lfuEntry[K, V]]
+}
+
+// lfuCache keeps a list of buckets sorted by increasing frequency, holding only the frequencies in use.
+// Using an entry moves it to the bucket of the next frequency, which is either the following one or a new
+// one inserted after it, so every operation takes constant time.
+type lfuCache[K comparable, V any] struct {
+	policy[K, V]
+	buckets ADTList.DoublyLinkedList[*frequencyBucket[K, V]]
+	entries ADTMap.Map[K, *lfuEntry[K, V]]
+}
+
+// ===================== NewLFUCache ==========================
+
+// NewLFUCache creates a cache that, when full, evicts the least frequently used entry. Among the entries used
+// the same number of times, the least recently used one is evicted.
+func NewLFUCache[K comparable, V any](capacity int) Cache[K, V] {
+	return NewLFUCacheWithOptions(capacity, Options[K, V]{})
+}
+
+// NewLFUCacheWithOptions creates an LFU cache whose entries expire and are notified as set in the options.
+func NewLFUCacheWithOptions[K comparable, V any](capacity int, options Options[K, V]) Cache[K, V] {
+	cache := new(lfuCache[K, V])
+	cache.policy = newPolicy(capacity, options)
+	cache.buckets = ADTList.NewDoublyLinkedList[*frequencyBucket[K, V]]()
+	cache.entries = ADTMap.NewHash[K, *lfuEntry[K, V]]()
+	return cache
+}
+
+// ===================== LFU Helpers ==========================
+
+func newBucket[K comparable, V any](frequency int) *frequencyBucket[K, V] {
+	bucket := new(frequencyBucket[K, V])
+	bucket.frequency = frequency
+	bucket.entries = ADTList.NewDoublyLinkedList[*lfuEntry[K, V]]()
+	return bucket
+}
+
+// find returns the entry of the key, or nil if it is not cached. An expired entry is dropped on the way.
+func (cache *lfuCache[K, V]) find(key K) *lfuEntry[K, V] {
+	if !cache.entries.Contains(key) {
+		return nil
+	}
+	entry := cache.entries.Get(key)
+	if cache.expired(entry.expiration) {
+		cache.drop(entry, true)
+		return nil
+	}
+	return entry
+}
+
+// unlink removes the entry from its bucket, and the bucket from the list if it was left empty.
+func (cache *lfuCache[K, V]) unlink(entry *lfuEntry[K, V]) {
+	bucket := entry.bucket.Value
+	bucket.entries.Remove(entry.node)
+	if bucket.entries.IsEmpty() {
+		cache.buckets.Remove(entry.bucket)
+	}
+}
+
+func (cache *lfuCache[K, V]) drop(entry *lfuEntry[K, V], expired bool) {
+	cache.unlink(entry)
+	cache.entries.Remove(entry.key)
+	cache.dropped(entry.key, entry.value, expired)
+}
+
+// touch moves the entry to the bucket of the next frequency.
+func (cache *lfuCache[K, V]) touch(entry *lfuEntry[K, V]) {
+	current := entry.bucket
+	next := current.Next()
+	if next == nil || next.Value.frequency != current.Value.frequency+1 {
+		next = cache.buckets.InsertAfter(current, newBucket[K, V](current.Value.frequency+1))
+	}
+	cache.unlink(entry)
+	entry.bucket = next
+	entry.node = next.Value.entries.PushFirst(entry)
+}
+
+// ===================== Put() and Get() ==========================
+
+func (cache *lfuCache[K, V]) Put(key K, value V) {
+	if entry := cache.find(key); entry != nil {
+		entry.value = value
+		entry.expiration = cache.expiration()
+		cache.touch(entry)
+		return
+	}
+
+	if cache.entries.Count() == cache.capacity {
+		victim := cache.buckets.FirstNode().Value.entries.LastNode().Value
+		cache.drop(victim, cache.expired(victim.expiration))
+	}
+
+	first := cache.buckets.FirstNode()
+	if first == nil || first.Value.frequency != 1 {
+		first = cache.buckets.PushFirst(newBucket[K, V](1))
+	}
+	entry := &lfuEntry[K, V]{key: key, value: value, expiration: cache.expiration(), bucket: first}
+	entry.node = first.Value.entries.PushFirst(entry)
+	cache.entries.Save(key, entry)
+}
+
+func (cache *lfuCache[K, V]) Get(key K) (V, bool) {
+	entry := cache.find(key)
+	if entry == nil {
+		cache.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	cache.stats.Hits++
+	cache.touch(entry)
+	return entry.value, true
+}
+
+// ===================== Contains() and Remove() ==========================
+
+func (cache *lfuCache[K, V]) Contains(key K) bool {
+	return cache.find(key) != nil
+}
+
+func (cache *lfuCache[K, V]) Remove(key K) V {
+	entry := cache.find(key)
+	if entry == nil {
+		panic(_KEY_NOT_CACHED)
+	}
+	cache.unlink(entry)
+	cache.entries.Remove(key)
+	return entry.value
+}
+
+func (cache *lfuCache[K, V]) RemoveExpired() int {
+	removed := 0
+	for bucket := cache.buckets.FirstNode(); bucket != nil; {
+		nextBucket := bucket.Next()
+		for node := bucket.Value.entries.FirstNode(); node != nil; {
+			next := node.Next()
+			if cache.expired(node.Value.expiration) {
+				cache.drop(node.Value, true)
+				removed++
+			}
+			node = next
+		}
+		bucket = nextBucket
+	}
+	return removed
+}
+
+// ===================== Count() ==========================
+
+func (cache *lfuCache[K, V]) Count() int {
+	return cache.entries.Count()
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"time"
+
+	ADTList "github.com/sebagarciad/algorithms-and-data-structures/linked_list"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+)
+
+// ===================== Types ==========================
+
+type lruEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	expiration time.Time
+}
+
+// lruCache keeps the entries in a list from the most to the least recently used, and indexes their nodes
+// by key, so that an entry can be found and moved to the front in constant time.
+type lruCache[K comparable, V any] struct {
+	policy[K, V]
+	recency ADTList.DoublyLinkedList[lruEntry[K, V]]
+	nodes   ADTMap.Map[K, *ADTList.Node[lruEntry[K, V]]]
+}
+
+// ===================== NewLRUCache ==========================
+
+// NewLRUCache creates a cache that, when full, evicts the least recently used entry.
+func NewLRUCache[K comparable, V any](capacity int) Cache[K, V] {
+	return NewLRUCacheWithOptions(capacity, Options[K, V]{})
+}
+
+// NewLRUCacheWithOptions creates an LRU cache whose entries expire and are notified as set in the options.
+func NewLRUCacheWithOptions[K comparable, V any](capacity int, options Options[K, V]) Cache[K, V] {
+	cache := new(lruCache[K, V])
+	cache.policy = newPolicy(capacity, options)
+	cache.recency = ADTList.NewDoublyLinkedList[lruEntry[K, V]]()
+	cache.nodes = ADTMap.NewHash[K, *ADTList.Node[lruEntry[K, V]]]()
+	return cache
+}
+
+// ===================== LRU Helpers ==========================
+
+// find returns the node of the key, or nil if it is not cached. An expired entry is dropped on the way.
+func (cache *lruCache[K, V]) find(key K) *ADTList.Node[lruEntry[K, V]] {
+	if !cache.nodes.Contains(key) {
+		return nil
+	}
+	node := cache.nodes.Get(key)
+	if cache.expired(node.Value.expiration) {
+		cache.drop(node, true)
+		return nil
+	}
+	return node
+}
+
+func (cache *lruCache[K, V]) drop(node *ADTList.Node[lruEntry[K, V]], expired bool) {
+	entry := cache.recency.Remove(node)
+	cache.nodes.Remove(entry.key)
+	cache.dropped(entry.key, entry.value, expired)
+}
+
+// ===================== Put() and Get() ==========================
+
+func (cache *lruCache[K, V]) Put(key K, value V) {
+	entry := lruEntry[K, V]{key, value, cache.expiration()}
+	if node := cache.find(key); node != nil {
+		node.Value = entry
+		cache.recency.MoveToFirst(node)
+		return
+	}
+
+	if cache.nodes.Count() == cache.capacity {
+		last := cache.recency.LastNode()
+		cache.drop(last, cache.expired(last.Value.expiration))
+	}
+	cache.nodes.Save(key, cache.recency.PushFirst(entry))
+}
+
+func (cache *lruCache[K, V]) Get(key K) (V, bool) {
+	node := cache.find(key)
+	if node == nil {
+		cache.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	cache.stats.Hits++
+	cache.recency.MoveToFirst(node)
+	return node.Value.value, true
+}
+
+// ===================== Contains() and Remove() ==========================
+
+func (cache *lruCache[K, V]) Contains(key K) bool {
+	return cache.find(key) != nil
+}
+
+func (cache *lruCache[K, V]) Remove(key K) V {
+	node := cache.find(key)
+	if node == nil {
+		panic(_KEY_NOT_CACHED)
+	}
+	cache.nodes.Remove(key)
+	return cache.recency.Remove(node).value
+}
+
+func (cache *lruCache[K, V]) RemoveExpired() int {
+	removed := 0
+	for node := cache.recency.FirstNode(); node != nil; {
+		next := node.Next()
+		if cache.expired(node.Value.expiration) {
+			cache.drop(node, true)
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// ===================== Count() ==========================
+
+func (cache *lruCache[K, V]) Count() int {
+	return cache.nodes.Count()
+}
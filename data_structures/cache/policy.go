@@ -0,0 +1,61 @@
+package cache
+
+import "time"
+
+const (
+	_KEY_NOT_CACHED    = "The key does not belong to the cache"
+	_INVALID_CAPACITY  = "The capacity of the cache must be positive"
+	_NEGATIVE_DURATION = "The time to live of the entries cannot be negative"
+)
+
+// policy holds what the LRU and LFU caches have in common: the options and the statistics.
+type policy[K comparable, V any] struct {
+	capacity int
+	options  Options[K, V]
+	stats    Stats
+}
+
+func newPolicy[K comparable, V any](capacity int, options Options[K, V]) policy[K, V] {
+	if capacity <= 0 {
+		panic(_INVALID_CAPACITY)
+	}
+	if options.TTL < 0 {
+		panic(_NEGATIVE_DURATION)
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return policy[K, V]{capacity: capacity, options: options}
+}
+
+// expiration returns when an entry stored now expires, or the zero time if entries never expire.
+func (policy *policy[K, V]) expiration() time.Time {
+	if policy.options.TTL == 0 {
+		return time.Time{}
+	}
+	return policy.options.Now().Add(policy.options.TTL)
+}
+
+func (policy *policy[K, V]) expired(expiration time.Time) bool {
+	return !expiration.IsZero() && !policy.options.Now().Before(expiration)
+}
+
+// dropped records an entry dropped by the cache itself and notifies it.
+func (policy *policy[K, V]) dropped(key K, value V, expired bool) {
+	if expired {
+		policy.stats.Expirations++
+	} else {
+		policy.stats.Evictions++
+	}
+	if policy.options.OnEvict != nil {
+		policy.options.OnEvict(key, value)
+	}
+}
+
+func (policy *policy[K, V]) Capacity() int {
+	return policy.capacity
+}
+
+func (policy *policy[K, V]) Stats() Stats {
+	return policy.stats
+}
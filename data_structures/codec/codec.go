@@ -0,0 +1,283 @@
+package codec
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+)
+
+var (
+	// ErrTruncated is returned when the data ends before the value being decoded.
+	ErrTruncated = errors.New("codec: unexpected end of data")
+
+	// ErrTrailingData is returned when there is data left after the last value of a collection.
+	ErrTrailingData = errors.New("codec: unexpected data after the end of the collection")
+
+	// ErrOutOfRange is returned when a decoded number does not fit in the type of the value.
+	ErrOutOfRange = errors.New("codec: number out of range")
+
+	// ErrNotSerializable is returned by the wrappers of a collection that does not implement Serializable.
+	ErrNotSerializable = errors.New("codec: the collection is not serializable")
+)
+
+// Codec encodes values of type T in a compact binary form, and decodes them back.
+type Codec[T any] interface {
+	// Append appends the encoding of the value to the buffer and returns the extended buffer.
+	Append(buffer []byte, value T) ([]byte, error)
+
+	// Decode decodes a value from the beginning of the data, and returns it together with the rest of the data.
+	Decode(data []byte) (T, []byte, error)
+}
+
+type funcCodec[T any] struct {
+	append func([]byte, T) ([]byte, error)
+	decode func([]byte) (T, []byte, error)
+}
+
+func (codec funcCodec[T]) Append(buffer []byte, value T) ([]byte, error) {
+	return codec.append(buffer, value)
+}
+
+func (codec funcCodec[T]) Decode(data []byte) (T, []byte, error) {
+	return codec.decode(data)
+}
+
+// New creates a Codec from its two functions.
+func New[T any](append func([]byte, T) ([]byte, error), decode func([]byte) (T, []byte, error)) Codec[T] {
+	return funcCodec[T]{append, decode}
+}
+
+// ===================== Codec Helpers ==========================
+
+func decodeUvarint(data []byte) (uint64, []byte, error) {
+	value, read := binary.Uvarint(data)
+	if read <= 0 {
+		return 0, nil, ErrTruncated
+	}
+	return value, data[read:], nil
+}
+
+// decodeBytes decodes a length-prefixed byte sequence, which shares memory with the data.
+func decodeBytes(data []byte) ([]byte, []byte, error) {
+	length, data, err := decodeUvarint(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(data)) < length {
+		return nil, nil, ErrTruncated
+	}
+	return data[:length], data[length:], nil
+}
+
+func appendBytes(buffer []byte, value []byte) []byte {
+	buffer = binary.AppendUvarint(buffer, uint64(len(value)))
+	return append(buffer, value...)
+}
+
+// ===================== Basic Codecs ==========================
+
+// String encodes strings as their length followed by their bytes.
+func String() Codec[string] {
+	return New(
+		func(buffer []byte, value string) ([]byte, error) {
+			return appendBytes(buffer, []byte(value)), nil
+		},
+		func(data []byte) (string, []byte, error) {
+			value, rest, err := decodeBytes(data)
+			return string(value), rest, err
+		})
+}
+
+// Bytes encodes byte slices as their length followed by their bytes.
+func Bytes() Codec[[]byte] {
+	return New(
+		func(buffer []byte, value []byte) ([]byte, error) {
+			return appendBytes(buffer, value), nil
+		},
+		func(data []byte) ([]byte, []byte, error) {
+			value, rest, err := decodeBytes(data)
+			return append([]byte(nil), value...), rest, err
+		})
+}
+
+// Signed encodes signed integers as zig-zag varints, so that small numbers take few bytes.
+func Signed[T ~int | ~int8 | ~int16 | ~int32 | ~int64]() Codec[T] {
+	return New(
+		func(buffer []byte, value T) ([]byte, error) {
+			return binary.AppendVarint(buffer, int64(value)), nil
+		},
+		func(data []byte) (T, []byte, error) {
+			value, read := binary.Varint(data)
+			if read <= 0 {
+				return 0, nil, ErrTruncated
+			}
+			if int64(T(value)) != value {
+				return 0, nil, ErrOutOfRange
+			}
+			return T(value), data[read:], nil
+		})
+}
+
+// Unsigned encodes unsigned integers as varints, so that small numbers take few bytes.
+func Unsigned[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr]() Codec[T] {
+	return New(
+		func(buffer []byte, value T) ([]byte, error) {
+			return binary.AppendUvarint(buffer, uint64(value)), nil
+		},
+		func(data []byte) (T, []byte, error) {
+			value, rest, err := decodeUvarint(data)
+			if err != nil {
+				return 0, nil, err
+			}
+			if uint64(T(value)) != value {
+				return 0, nil, ErrOutOfRange
+			}
+			return T(value), rest, nil
+		})
+}
+
+// Float encodes floating point numbers as the 8 bytes of their IEEE 754 representation.
+func Float[T ~float32 | ~float64]() Codec[T] {
+	return New(
+		func(buffer []byte, value T) ([]byte, error) {
+			return binary.BigEndian.AppendUint64(buffer, math.Float64bits(float64(value))), nil
+		},
+		func(data []byte) (T, []byte, error) {
+			if len(data) < 8 {
+				return 0, nil, ErrTruncated
+			}
+			return T(math.Float64frombits(binary.BigEndian.Uint64(data))), data[8:], nil
+		})
+}
+
+// Bool encodes booleans as a single byte.
+func Bool() Codec[bool] {
+	return New(
+		func(buffer []byte, value bool) ([]byte, error) {
+			if value {
+				return append(buffer, 1), nil
+			}
+			return append(buffer, 0), nil
+		},
+		func(data []byte) (bool, []byte, error) {
+			if len(data) < 1 {
+				return false, nil, ErrTruncated
+			}
+			return data[0] != 0, data[1:], nil
+		})
+}
+
+// Time encodes instants with their own binary form, which keeps the location offset.
+func Time() Codec[time.Time] {
+	return New(
+		func(buffer []byte, value time.Time) ([]byte, error) {
+			encoded, err := value.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			return appendBytes(buffer, encoded), nil
+		},
+		func(data []byte) (time.Time, []byte, error) {
+			var value time.Time
+			encoded, rest, err := decodeBytes(data)
+			if err != nil {
+				return value, nil, err
+			}
+			return value, rest, value.UnmarshalBinary(encoded)
+		})
+}
+
+// JSON encodes any value that encoding/json supports as its length-prefixed JSON text.
+func JSON[T any]() Codec[T] {
+	return New(
+		func(buffer []byte, value T) ([]byte, error) {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			return appendBytes(buffer, encoded), nil
+		},
+		func(data []byte) (T, []byte, error) {
+			var value T
+			encoded, rest, err := decodeBytes(data)
+			if err != nil {
+				return value, nil, err
+			}
+			return value, rest, json.Unmarshal(encoded, &value)
+		})
+}
+
+// Slice encodes slices as their length followed by their elements.
+func Slice[T any](elements Codec[T]) Codec[[]T] {
+	return New(
+		func(buffer []byte, value []T) ([]byte, error) {
+			return AppendSequence(buffer, len(value), func(visit func(T) bool) {
+				for _, element := range value {
+					if !visit(element) {
+						return
+					}
+				}
+			}, elements)
+		},
+		func(data []byte) ([]T, []byte, error) {
+			value := []T{}
+			rest, err := DecodeSequence(data, elements, func(element T) { value = append(value, element) })
+			return value, rest, err
+		})
+}
+
+// Default returns the codec for the basic types, including time.Time, and falls back to JSON for the rest.
+// Slices and other composite types are encoded as JSON too; build their codec with Slice to get the binary form.
+func Default[T any]() Codec[T] {
+	var codec any
+	switch any(*new(T)).(type) {
+	case string:
+		codec = String()
+	case []byte:
+		codec = Bytes()
+	case bool:
+		codec = Bool()
+	case int:
+		codec = Signed[int]()
+	case int8:
+		codec = Signed[int8]()
+	case int16:
+		codec = Signed[int16]()
+	case int32:
+		codec = Signed[int32]()
+	case int64:
+		codec = Signed[int64]()
+	case uint:
+		codec = Unsigned[uint]()
+	case uint8:
+		codec = Unsigned[uint8]()
+	case uint16:
+		codec = Unsigned[uint16]()
+	case uint32:
+		codec = Unsigned[uint32]()
+	case uint64:
+		codec = Unsigned[uint64]()
+	case float32:
+		codec = Float[float32]()
+	case float64:
+		codec = Float[float64]()
+	case time.Time:
+		codec = Time()
+	default:
+		return JSON[T]()
+	}
+	return codec.(Codec[T])
+}
+
+// Serializable is implemented by the collections that can be encoded in binary and in JSON, and rebuilt
+// from either form. The ADT interfaces do not require it, so callers type-assert a collection to find out
+// whether it can be serialized. Every collection created by the constructors of this module implements it.
+type Serializable interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	json.Marshaler
+	json.Unmarshaler
+}
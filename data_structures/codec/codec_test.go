@@ -0,0 +1,95 @@
+package codec_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+
+	"github.com/stretchr/testify/require"
+)
+
+type point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func roundTrip[T any](t *testing.T, elements codec.Codec[T], value T) T {
+	encoded, err := elements.Append([]byte{0xFF}, value)
+	require.NoError(t, err)
+	require.Equal(t, byte(0xFF), encoded[0], "Append should keep the contents of the buffer")
+	decoded, rest, err := elements.Decode(append(encoded[1:], 0xEE))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xEE}, rest, "Decode should return the data after the value")
+	return decoded
+}
+
+func TestBasicCodecs(t *testing.T) {
+	require.Equal(t, "algoritmos", roundTrip(t, codec.String(), "algoritmos"))
+	require.Equal(t, "", roundTrip(t, codec.String(), ""))
+	require.Equal(t, []byte{1, 2, 3}, roundTrip(t, codec.Bytes(), []byte{1, 2, 3}))
+	require.Equal(t, true, roundTrip(t, codec.Bool(), true))
+	require.Equal(t, -12345, roundTrip(t, codec.Signed[int](), -12345))
+	require.Equal(t, int64(math.MinInt64), roundTrip(t, codec.Signed[int64](), math.MinInt64))
+	require.Equal(t, uint64(math.MaxUint64), roundTrip(t, codec.Unsigned[uint64](), math.MaxUint64))
+	require.Equal(t, 3.25, roundTrip(t, codec.Float[float64](), 3.25))
+	require.Equal(t, point{1, 2}, roundTrip(t, codec.JSON[point](), point{1, 2}))
+	require.Equal(t, []string{"a", "b"}, roundTrip(t, codec.Slice(codec.String()), []string{"a", "b"}))
+
+	instant := time.Date(2024, 3, 1, 12, 30, 0, 0, time.FixedZone("ART", -3*60*60))
+	require.True(t, instant.Equal(roundTrip(t, codec.Time(), instant)))
+}
+
+func TestDefaultCodec(t *testing.T) {
+	require.Equal(t, -7, roundTrip(t, codec.Default[int](), -7))
+	require.Equal(t, uint8(200), roundTrip(t, codec.Default[uint8](), 200))
+	require.Equal(t, "x", roundTrip(t, codec.Default[string](), "x"))
+	require.Equal(t, point{3, 4}, roundTrip(t, codec.Default[point](), point{3, 4}))
+
+	encoded, err := codec.Default[int]().Append(nil, 300)
+	require.NoError(t, err)
+	require.Len(t, encoded, 2, "Integers should be encoded as varints")
+}
+
+func TestCodecErrors(t *testing.T) {
+	_, _, err := codec.String().Decode([]byte{5, 'a'})
+	require.ErrorIs(t, err, codec.ErrTruncated)
+	_, _, err = codec.Float[float64]().Decode([]byte{1, 2})
+	require.ErrorIs(t, err, codec.ErrTruncated)
+
+	encoded, _ := codec.Signed[int]().Append(nil, 1000)
+	_, _, err = codec.Signed[int8]().Decode(encoded)
+	require.ErrorIs(t, err, codec.ErrOutOfRange)
+
+	encoded, _ = codec.MarshalSequence(1, func(visit func(int) bool) { visit(1) }, codec.Signed[int]())
+	require.ErrorIs(t, codec.UnmarshalSequence(append(encoded, 0), codec.Signed[int](), func(int) {}),
+		codec.ErrTrailingData)
+	require.ErrorIs(t, codec.UnmarshalSequence(encoded[:1], codec.Signed[int](), func(int) {}), codec.ErrTruncated)
+}
+
+func TestPairs(t *testing.T) {
+	pairs := []codec.Pair[string, int]{{"b", 2}, {"a", 1}}
+	iterate := func(visit func(string, int) bool) {
+		for _, pair := range pairs {
+			if !visit(pair.Key, pair.Value) {
+				return
+			}
+		}
+	}
+
+	decoded := []codec.Pair[string, int]{}
+	add := func(key string, value int) { decoded = append(decoded, codec.Pair[string, int]{key, value}) }
+
+	encoded, err := codec.MarshalPairs(len(pairs), iterate, codec.String(), codec.Signed[int]())
+	require.NoError(t, err)
+	require.NoError(t, codec.UnmarshalPairs(encoded, codec.String(), codec.Signed[int](), add))
+	require.Equal(t, pairs, decoded, "The pairs should keep their order")
+
+	encoded, err = codec.MarshalPairsJSON(len(pairs), iterate)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"key":"b","value":2},{"key":"a","value":1}]`, string(encoded))
+	decoded = decoded[:0]
+	require.NoError(t, codec.UnmarshalPairsJSON(encoded, add))
+	require.Equal(t, pairs, decoded)
+}
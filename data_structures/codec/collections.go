@@ -0,0 +1,157 @@
+package codec
+
+import (
+	"encoding/binary"
+	"encoding/json"
+)
+
+// The helpers in this file encode the contents of the collections, which pass them the functions to
+// traverse and to rebuild themselves. A sequence is encoded as its length followed by its elements, in the
+// order they are traversed, and decoding adds them back in that same order.
+
+// Pair is a key-value pair as encoded in JSON by the maps.
+type Pair[K any, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// Collect returns the elements visited by iterate, in order. Encoding the snapshot taken by a single traversal
+// keeps the count consistent with the elements, even for a collection that other goroutines may modify.
+func Collect[T any](iterate func(visit func(T) bool)) []T {
+	elements := []T{}
+	iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	return elements
+}
+
+// ===================== Binary ==========================
+
+// AppendSequence appends the count of elements and then each element visited by iterate.
+func AppendSequence[T any](buffer []byte, count int, iterate func(visit func(T) bool), elements Codec[T]) ([]byte, error) {
+	buffer = binary.AppendUvarint(buffer, uint64(count))
+	var err error
+	iterate(func(element T) bool {
+		buffer, err = elements.Append(buffer, element)
+		return err == nil
+	})
+	return buffer, err
+}
+
+// DecodeSequence decodes a sequence from the beginning of the data, calling add with each element, and
+// returns the rest of the data.
+func DecodeSequence[T any](data []byte, elements Codec[T], add func(T)) ([]byte, error) {
+	count, data, err := decodeUvarint(data)
+	if err != nil {
+		return nil, err
+	}
+	for ; count > 0; count-- {
+		var element T
+		if element, data, err = elements.Decode(data); err != nil {
+			return nil, err
+		}
+		add(element)
+	}
+	return data, nil
+}
+
+// MarshalSequence encodes the elements visited by iterate.
+func MarshalSequence[T any](count int, iterate func(visit func(T) bool), elements Codec[T]) ([]byte, error) {
+	return AppendSequence(nil, count, iterate, elements)
+}
+
+// UnmarshalSequence decodes the elements of a sequence, which must take up the whole data, calling add
+// with each of them.
+func UnmarshalSequence[T any](data []byte, elements Codec[T], add func(T)) error {
+	rest, err := DecodeSequence(data, elements, add)
+	if err == nil && len(rest) > 0 {
+		return ErrTrailingData
+	}
+	return err
+}
+
+// MarshalPairs encodes the count of pairs and then the key and the value of each pair visited by iterate.
+func MarshalPairs[K any, V any](count int, iterate func(visit func(K, V) bool), keys Codec[K], values Codec[V]) ([]byte, error) {
+	buffer := binary.AppendUvarint(nil, uint64(count))
+	var err error
+	iterate(func(key K, value V) bool {
+		if buffer, err = keys.Append(buffer, key); err == nil {
+			buffer, err = values.Append(buffer, value)
+		}
+		return err == nil
+	})
+	return buffer, err
+}
+
+// UnmarshalPairs decodes the pairs encoded by MarshalPairs, calling add with each of them.
+func UnmarshalPairs[K any, V any](data []byte, keys Codec[K], values Codec[V], add func(K, V)) error {
+	count, data, err := decodeUvarint(data)
+	if err != nil {
+		return err
+	}
+	for ; count > 0; count-- {
+		var (
+			key   K
+			value V
+		)
+		if key, data, err = keys.Decode(data); err != nil {
+			return err
+		}
+		if value, data, err = values.Decode(data); err != nil {
+			return err
+		}
+		add(key, value)
+	}
+	if len(data) > 0 {
+		return ErrTrailingData
+	}
+	return nil
+}
+
+// ===================== JSON ==========================
+
+// MarshalSequenceJSON encodes the elements visited by iterate as a JSON array.
+func MarshalSequenceJSON[T any](count int, iterate func(visit func(T) bool)) ([]byte, error) {
+	elements := make([]T, 0, count)
+	iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	return json.Marshal(elements)
+}
+
+// UnmarshalSequenceJSON decodes a JSON array, calling add with each of its elements in order.
+func UnmarshalSequenceJSON[T any](data []byte, add func(T)) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	for _, element := range elements {
+		add(element)
+	}
+	return nil
+}
+
+// MarshalPairsJSON encodes the pairs visited by iterate as a JSON array of objects with a "key" and a
+// "value", which keeps their order and allows keys of any type.
+func MarshalPairsJSON[K any, V any](count int, iterate func(visit func(K, V) bool)) ([]byte, error) {
+	pairs := make([]Pair[K, V], 0, count)
+	iterate(func(key K, value V) bool {
+		pairs = append(pairs, Pair[K, V]{key, value})
+		return true
+	})
+	return json.Marshal(pairs)
+}
+
+// UnmarshalPairsJSON decodes the pairs encoded by MarshalPairsJSON, calling add with each of them in order.
+func UnmarshalPairsJSON[K any, V any](data []byte, add func(K, V)) error {
+	var pairs []Pair[K, V]
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		add(pair.Key, pair.Value)
+	}
+	return nil
+}
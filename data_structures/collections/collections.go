@@ -0,0 +1,119 @@
+// Package collections copies, compares and transforms the ADTs without writing the loops by hand. Its
+// functions work on the shape shared by the ADTs rather than on each interface: a Sequence visits elements one
+// by one, as lists, stacks, queues, priority queues and sets do, while Pairs visits key-value pairs, as maps
+// do. The functions that build a collection receive it already created, usually empty, so that the caller
+// chooses its kind and configuration, such as the comparator of an ordered map, and get it back filled.
+//
+// Elements and values are copied by assignment, so a copy of a collection of pointers shares what they point to.
+package collections
+
+// _NOT_A_COLLECTION is the panic of the functions that add elements to a value they do not know how to fill.
+const _NOT_A_COLLECTION = "The target collection does not support adding elements"
+
+// Sequence is implemented by the collections that visit their elements one by one.
+type Sequence[T any] interface {
+	Iterate(visit func(T) bool)
+}
+
+// adder returns the method that adds an element to the collection, so that it is visited after the elements
+// that were already there, except for the collections without an order of their own. If the collection has
+// none of the methods used by the ADTs to add elements, it panics with the message
+// "The target collection does not support adding elements".
+func adder[T any](collection any) func(T) {
+	switch collection := collection.(type) {
+	case interface{ InsertLast(T) }:
+		return collection.InsertLast
+	case interface{ Push(T) }:
+		return collection.Push
+	case interface{ Enqueue(T) }:
+		return collection.Enqueue
+	case interface{ Add(T) }:
+		return collection.Add
+	}
+	panic(_NOT_A_COLLECTION)
+}
+
+// ===================== Slices ==========================
+
+// ToSlice returns the elements of the collection in the order it visits them. A stack is visited from the
+// bottom to the top.
+func ToSlice[T any](source Sequence[T]) []T {
+	elements := []T{}
+	source.Iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	return elements
+}
+
+// FromSlice adds the elements to the target collection in order, and returns it. The first element is pushed
+// first onto a stack, so ToSlice returns the elements in the same order.
+func FromSlice[C Sequence[T], T any](target C, elements []T) C {
+	add := adder[T](target)
+	for _, element := range elements {
+		add(element)
+	}
+	return target
+}
+
+// ===================== Copies ==========================
+
+// Clone adds the elements of source to the target collection in the order source visits them, and returns
+// it. Passing a new collection of the same kind as source copies it, and passing one of another kind converts
+// it, as a stack into a list. Source is not modified.
+func Clone[C Sequence[T], T any](source Sequence[T], target C) C {
+	return Filter(source, target, func(T) bool { return true })
+}
+
+// Filter adds to the target collection the elements of source for which keep returns true, in the order
+// source visits them, and returns it.
+func Filter[C Sequence[T], T any](source Sequence[T], target C, keep func(T) bool) C {
+	add := adder[T](target)
+	source.Iterate(func(element T) bool {
+		if keep(element) {
+			add(element)
+		}
+		return true
+	})
+	return target
+}
+
+// ===================== Comparison ==========================
+
+// Equal returns true if both collections visit the same number of elements and eq returns true for each pair
+// of elements visited at the same position. The order matters, so two sets or priority queues with the same
+// elements might not be equal; their sorted ToSlice should be compared instead.
+func Equal[T any](a, b Sequence[T], eq func(T, T) bool) bool {
+	elements := ToSlice(a)
+	i, equal := 0, true
+	b.Iterate(func(element T) bool {
+		equal = i < len(elements) && eq(elements[i], element)
+		i++
+		return equal
+	})
+	return equal && i == len(elements)
+}
+
+// ===================== Aggregation ==========================
+
+// Reduce combines the elements of the collection in the order it visits them, starting from initial.
+func Reduce[T any, A any](source Sequence[T], initial A, combine func(A, T) A) A {
+	result := initial
+	source.Iterate(func(element T) bool {
+		result = combine(result, element)
+		return true
+	})
+	return result
+}
+
+// GroupBy divides the elements of the collection by the key that key returns for each of them. The elements
+// of each group keep the order in which the collection visits them.
+func GroupBy[T any, K comparable](source Sequence[T], key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	source.Iterate(func(element T) bool {
+		k := key(element)
+		groups[k] = append(groups[k], element)
+		return true
+	})
+	return groups
+}
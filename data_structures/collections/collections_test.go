@@ -0,0 +1,153 @@
+package collections_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	"github.com/sebagarciad/algorithms-and-data-structures/collections"
+	ADTList "github.com/sebagarciad/algorithms-and-data-structures/linked_list"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+	TDAHeap "github.com/sebagarciad/algorithms-and-data-structures/priority_queue"
+	ADTQueue "github.com/sebagarciad/algorithms-and-data-structures/queue"
+	ADTSet "github.com/sebagarciad/algorithms-and-data-structures/set"
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+
+	"github.com/stretchr/testify/require"
+)
+
+func cmpInt(a, b int) int {
+	return a - b
+}
+
+func equalInts(a, b int) bool {
+	return a == b
+}
+
+func TestSliceRoundTrips(t *testing.T) {
+	elements := []int{3, 1, 4, 1, 5}
+	list := collections.FromSlice(ADTList.NewLinkedList[int](), elements)
+	require.Equal(t, elements, collections.ToSlice(list))
+	stack := collections.FromSlice(ADTStack.NewStack[int](), elements)
+	require.Equal(t, 5, stack.Peek(), "The last element should be at the top of the stack")
+	require.Equal(t, elements, collections.ToSlice(stack))
+	queue := collections.FromSlice(ADTQueue.NewLinkedQueue[int](), elements)
+	require.Equal(t, 3, queue.Peek())
+	require.Equal(t, elements, collections.ToSlice(queue))
+
+	heap := collections.FromSlice(TDAHeap.NewHeap(cmpInt), elements)
+	require.Equal(t, 5, heap.PeekMax())
+	require.ElementsMatch(t, elements, collections.ToSlice(heap))
+	set := collections.FromSlice(ADTSet.NewHashSet[int](), elements)
+	require.ElementsMatch(t, []int{1, 3, 4, 5}, collections.ToSlice(set))
+
+	require.Empty(t, collections.ToSlice(ADTList.NewLinkedList[int]()))
+	require.PanicsWithValue(t, "The target collection does not support adding elements", func() {
+		collections.FromSlice(ADTList.List[int](nil), elements)
+	})
+}
+
+func TestClone(t *testing.T) {
+	stack := collections.FromSlice(ADTStack.NewStack[int](), []int{1, 2, 3})
+	clone := collections.Clone(stack, ADTStack.NewStack[int]())
+	clone.Pop()
+	clone.Push(4)
+	require.Equal(t, []int{1, 2, 3}, collections.ToSlice(stack), "Modifying the clone should not modify the original")
+	require.Equal(t, []int{1, 2, 4}, collections.ToSlice(clone))
+
+	list := collections.Clone(stack, ADTList.NewLinkedList[int]())
+	require.Equal(t, 1, list.SeeFirst(), "A stack should become a list from its bottom to its top")
+	require.Equal(t, 3, list.Length())
+}
+
+func TestEqual(t *testing.T) {
+	list := collections.FromSlice(ADTList.NewLinkedList[int](), []int{1, 2, 3})
+	doubly := collections.FromSlice(ADTList.NewDoublyLinkedList[int](), []int{1, 2, 3})
+	queue := collections.FromSlice(ADTQueue.NewLinkedQueue[int](), []int{1, 2, 3})
+	require.True(t, collections.Equal(list, doubly, equalInts))
+	require.True(t, collections.Equal(list, queue, equalInts), "Collections of different kinds can be equal")
+
+	doubly.InsertLast(4)
+	require.False(t, collections.Equal(list, doubly, equalInts))
+	require.False(t, collections.Equal(doubly, list, equalInts))
+	doubly.DeleteFirst()
+	require.False(t, collections.Equal(list, doubly, equalInts))
+	require.True(t, collections.Equal(ADTList.NewLinkedList[int](), ADTStack.NewStack[int](), equalInts))
+
+	words := collections.FromSlice(ADTList.NewLinkedList[string](), []string{"a", "B"})
+	upper := collections.FromSlice(ADTList.NewLinkedList[string](), []string{"A", "b"})
+	require.True(t, collections.Equal(words, upper, strings.EqualFold))
+}
+
+func TestFilterReduceAndGroupBy(t *testing.T) {
+	queue := collections.FromSlice(ADTQueue.NewLinkedQueue[int](), []int{1, 2, 3, 4, 5, 6})
+	even := collections.Filter(queue, ADTList.NewLinkedList[int](), func(element int) bool {
+		return element%2 == 0
+	})
+	require.Equal(t, []int{2, 4, 6}, collections.ToSlice(even))
+	require.Equal(t, 1, queue.Peek(), "Filtering should not modify the source")
+
+	sum := collections.Reduce(queue, 0, func(total, element int) int { return total + element })
+	require.Equal(t, 21, sum)
+	joined := collections.Reduce(even, "", func(text string, element int) string {
+		return text + string(rune('0'+element))
+	})
+	require.Equal(t, "246", joined)
+
+	groups := collections.GroupBy(queue, func(element int) int { return element % 3 })
+	require.Equal(t, map[int][]int{0: {3, 6}, 1: {1, 4}, 2: {2, 5}}, groups)
+	require.Empty(t, collections.GroupBy(ADTQueue.NewLinkedQueue[int](), func(int) int { return 0 }))
+}
+
+func TestMaps(t *testing.T) {
+	hash := ADTMap.NewHash[string, int]()
+	for i, word := range []string{"zero", "one", "two", "three"} {
+		hash.Save(word, i)
+	}
+
+	tree := collections.CloneMap(hash, ADTMap.CreateBST[string, int](strings.Compare))
+	require.True(t, collections.EqualMaps(hash, tree, equalInts), "Maps of different kinds can be equal")
+	keys := []string{}
+	for _, pair := range collections.ToPairs(tree) {
+		keys = append(keys, pair.Key)
+	}
+	require.Equal(t, []string{"one", "three", "two", "zero"}, keys, "The clone should keep its own order")
+
+	tree.Save("four", 4)
+	require.False(t, collections.EqualMaps(hash, tree, equalInts))
+	require.False(t, collections.EqualMaps(tree, hash, equalInts))
+	tree.Remove("four")
+	tree.Save("zero", -1)
+	require.False(t, collections.EqualMaps(hash, tree, equalInts))
+	require.Equal(t, 0, hash.Get("zero"), "Modifying the clone should not modify the original")
+
+	odd := collections.FilterMap(hash, ADTMap.NewHash[string, int](), func(_ string, value int) bool {
+		return value%2 == 1
+	})
+	require.Equal(t, 2, odd.Count())
+	require.True(t, odd.Contains("one"))
+	require.True(t, odd.Contains("three"))
+
+	lengths := collections.MapValues(hash, ADTMap.NewHash[string, string](), func(value int) string {
+		return strings.Repeat("*", value)
+	})
+	require.Equal(t, "***", lengths.Get("three"))
+
+	total := collections.ReduceMap(hash, 0, func(total int, _ string, value int) int { return total + value })
+	require.Equal(t, 6, total)
+
+	pairs := []codec.Pair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}, {Key: "a", Value: 3}}
+	fromPairs := collections.FromPairs(ADTMap.NewLinkedHash[string, int](), pairs)
+	require.Equal(t, 3, fromPairs.Get("a"), "The last value of a repeated key should be kept")
+	require.Equal(t, []codec.Pair[string, int]{{Key: "a", Value: 3}, {Key: "b", Value: 2}}, collections.ToPairs(fromPairs))
+}
+
+func TestMultisetPairs(t *testing.T) {
+	multiset := ADTSet.NewMultiset[string]()
+	multiset.AddCount("a", 2)
+	multiset.AddCount("b", 3)
+	counts := collections.ToPairs(multiset)
+	slices.SortFunc(counts, func(x, y codec.Pair[string, int]) int { return strings.Compare(x.Key, y.Key) })
+	require.Equal(t, []codec.Pair[string, int]{{Key: "a", Value: 2}, {Key: "b", Value: 3}}, counts)
+}
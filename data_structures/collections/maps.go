@@ -0,0 +1,89 @@
+package collections
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+)
+
+// Pairs is implemented by the collections that visit key-value pairs, as the maps do.
+type Pairs[K comparable, V any] interface {
+	Iterate(visit func(K, V) bool)
+}
+
+// ===================== Slices ==========================
+
+// ToPairs returns the key-value pairs of the collection in the order it visits them.
+func ToPairs[K comparable, V any](source Pairs[K, V]) []codec.Pair[K, V] {
+	pairs := []codec.Pair[K, V]{}
+	source.Iterate(func(key K, value V) bool {
+		pairs = append(pairs, codec.Pair[K, V]{Key: key, Value: value})
+		return true
+	})
+	return pairs
+}
+
+// FromPairs saves the pairs in the target map in order, so the last value of a repeated key is kept, and
+// returns it.
+func FromPairs[M ADTMap.Map[K, V], K comparable, V any](target M, pairs []codec.Pair[K, V]) M {
+	for _, pair := range pairs {
+		target.Save(pair.Key, pair.Value)
+	}
+	return target
+}
+
+// ===================== Copies ==========================
+
+// CloneMap saves the pairs of source in the target map, and returns it. Passing a new map of the same kind as
+// source copies it, and passing one of another kind converts it, as a hash map into an ordered one.
+func CloneMap[M ADTMap.Map[K, V], K comparable, V any](source Pairs[K, V], target M) M {
+	return FilterMap(source, target, func(K, V) bool { return true })
+}
+
+// FilterMap saves in the target map the pairs of source for which keep returns true, and returns it.
+func FilterMap[M ADTMap.Map[K, V], K comparable, V any](source Pairs[K, V], target M, keep func(K, V) bool) M {
+	source.Iterate(func(key K, value V) bool {
+		if keep(key, value) {
+			target.Save(key, value)
+		}
+		return true
+	})
+	return target
+}
+
+// MapValues saves in the target map each key of source with its value transformed, and returns it.
+func MapValues[M ADTMap.Map[K, W], K comparable, V any, W any](source Pairs[K, V], target M, transform func(V) W) M {
+	source.Iterate(func(key K, value V) bool {
+		target.Save(key, transform(value))
+		return true
+	})
+	return target
+}
+
+// ===================== Comparison ==========================
+
+// EqualMaps returns true if both maps have the same keys and eq returns true for the values of each key. The
+// order of the keys does not matter, so maps of different kinds can be equal.
+func EqualMaps[K comparable, V any](a, b ADTMap.MapReader[K, V], eq func(V, V) bool) bool {
+	if a.Count() != b.Count() {
+		return false
+	}
+	equal := true
+	a.Iterate(func(key K, value V) bool {
+		other, err := ADTMap.TryGet(b, key)
+		equal = err == nil && eq(value, other)
+		return equal
+	})
+	return equal
+}
+
+// ===================== Aggregation ==========================
+
+// ReduceMap combines the pairs of the collection in the order it visits them, starting from initial.
+func ReduceMap[K comparable, V any, A any](source Pairs[K, V], initial A, combine func(A, K, V) A) A {
+	result := initial
+	source.Iterate(func(key K, value V) bool {
+		result = combine(result, key, value)
+		return true
+	})
+	return result
+}
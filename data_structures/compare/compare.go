@@ -0,0 +1,64 @@
+// Package compare builds the comparators taken by the ordered ADTs, such as CreateBST and NewHeap, out of
+// smaller ones, instead of writing each reversed, by-key or tie-breaking comparator by hand. For example, the
+// resources with the most visits, and among them the first by name, are given by
+//
+//	compare.ThenBy(compare.Reverse(compare.By(visits)), compare.By(name))
+package compare
+
+import "cmp"
+
+// Func is a comparator. It returns a negative number if a goes before b, a positive number if a goes after b,
+// and 0 if they are equivalent. A Func can be passed wherever a func(T, T) int is expected.
+type Func[T any] func(a, b T) int
+
+// Natural orders the values from the lowest to the greatest. Like cmp.Compare, it places NaN before any other
+// floating-point number.
+func Natural[T cmp.Ordered]() Func[T] {
+	return cmp.Compare[T]
+}
+
+// Reverse orders the values the other way around than the given comparator.
+func Reverse[T any](compare Func[T]) Func[T] {
+	return func(a, b T) int {
+		return compare(b, a)
+	}
+}
+
+// By orders the values by the natural order of the key that key returns for each of them.
+func By[T any, K cmp.Ordered](key func(T) K) Func[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// ThenBy orders the values by the first comparator, and breaks the ties between them with each of the next ones
+// in turn.
+func ThenBy[T any](first Func[T], next ...Func[T]) Func[T] {
+	return func(a, b T) int {
+		if result := first(a, b); result != 0 {
+			return result
+		}
+		for _, compare := range next {
+			if result := compare(a, b); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
+
+// NilsFirst orders the pointers by the values they point to, as the given comparator does, placing the nil
+// pointers before the rest.
+func NilsFirst[T any](compare Func[T]) Func[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		case b == nil:
+			return 1
+		}
+		return compare(*a, *b)
+	}
+}
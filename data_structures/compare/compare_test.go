@@ -0,0 +1,71 @@
+package compare_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/compare"
+
+	"github.com/stretchr/testify/require"
+)
+
+type resource struct {
+	name   string
+	visits int
+}
+
+func TestNatural(t *testing.T) {
+	numbers := []float64{3, math.NaN(), -1, 2.5}
+	slices.SortFunc(numbers, compare.Natural[float64]())
+	require.True(t, math.IsNaN(numbers[0]), "NaN should go before any other number")
+	require.Equal(t, []float64{-1, 2.5, 3}, numbers[1:])
+
+	words := []string{"b", "c", "a"}
+	slices.SortFunc(words, compare.Natural[string]())
+	require.Equal(t, []string{"a", "b", "c"}, words)
+	require.Zero(t, compare.Natural[string]()("a", "a"))
+}
+
+func TestReverse(t *testing.T) {
+	numbers := []int{2, 3, 1}
+	slices.SortFunc(numbers, compare.Reverse(compare.Natural[int]()))
+	require.Equal(t, []int{3, 2, 1}, numbers)
+	require.Zero(t, compare.Reverse(compare.Natural[int]())(1, 1))
+
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	words := []string{"a", "ccc", "bb"}
+	slices.SortFunc(words, compare.Reverse(byLength))
+	require.Equal(t, []string{"ccc", "bb", "a"}, words, "Plain functions should be accepted as comparators")
+}
+
+func TestByAndThenBy(t *testing.T) {
+	visits := func(r resource) int { return r.visits }
+	name := func(r resource) string { return r.name }
+	resources := []resource{{"/b", 2}, {"/c", 5}, {"/a", 2}, {"/d", 1}}
+
+	slices.SortStableFunc(resources, compare.By(visits))
+	require.Equal(t, []resource{{"/d", 1}, {"/b", 2}, {"/a", 2}, {"/c", 5}}, resources)
+
+	slices.SortFunc(resources, compare.ThenBy(compare.Reverse(compare.By(visits)), compare.By(name)))
+	require.Equal(t, []resource{{"/c", 5}, {"/a", 2}, {"/b", 2}, {"/d", 1}}, resources,
+		"Ties in the number of visits should be broken by name")
+
+	require.Zero(t, compare.ThenBy(compare.By(visits))(resource{"/a", 1}, resource{"/b", 1}),
+		"Without further comparators a tie should remain")
+	require.Negative(t, compare.ThenBy(compare.By(visits), compare.By(visits), compare.By(name))(
+		resource{"/a", 1}, resource{"/b", 1}), "Every comparator should be tried in turn")
+}
+
+func TestNilsFirst(t *testing.T) {
+	one, two := 1, 2
+	pointers := []*int{&two, nil, &one, nil}
+	slices.SortFunc(pointers, compare.NilsFirst(compare.Natural[int]()))
+	require.Nil(t, pointers[0])
+	require.Nil(t, pointers[1])
+	require.Equal(t, []int{1, 2}, []int{*pointers[2], *pointers[3]})
+
+	reversed := compare.Reverse(compare.NilsFirst(compare.Natural[int]()))
+	require.Positive(t, reversed(nil, &one), "Reversing should place the nil pointers last")
+	require.Zero(t, compare.NilsFirst(compare.Natural[int]())(nil, nil))
+}
@@ -0,0 +1,33 @@
+// Package debug exposes the internals of the ADTs, to find out whether a structure was corrupted when something
+// goes wrong and to look at its shape. The ADTs that can check their invariants implement Validator, and the
+// tree-shaped ones that can render themselves implement Inspector. Neither is part of their public interfaces,
+// so they can be reached only on purpose, by a type assertion.
+package debug
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvariant is wrapped by every error returned by Validate.
+var ErrInvariant = errors.New("debug: broken invariant")
+
+// Validator is implemented by the ADTs that can check their structural invariants.
+type Validator interface {
+	// Validate walks the whole structure and returns an error wrapping ErrInvariant that describes the first
+	// broken invariant it finds, or nil if there is none. It takes linear time and does not modify the structure.
+	Validate() error
+}
+
+// Validate validates the given value if it is a Validator, and returns nil otherwise.
+func Validate(value any) error {
+	if validator, ok := value.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// Violation returns an error wrapping ErrInvariant with the formatted description of the broken invariant.
+func Violation(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvariant, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,144 @@
+package debug
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TreeNode is a node of a binary tree copied from an ADT to be rendered. Its label is the text shown for it.
+type TreeNode struct {
+	Label string
+	Left  *TreeNode
+	Right *TreeNode
+}
+
+// TreeStats describes the shape of a binary tree.
+type TreeStats struct {
+	// Nodes is the number of nodes of the tree
+	Nodes int
+
+	// Height is the number of levels of the tree, 0 if it is empty
+	Height int
+
+	// Leaves is the number of nodes without children
+	Leaves int
+
+	// AverageDepth is the average number of edges from the root to a node, which is proportional to the
+	// average cost of a search
+	AverageDepth float64
+
+	// MaxImbalance is the largest difference between the heights of the two subtrees of a node
+	MaxImbalance int
+}
+
+// Inspector is implemented by the tree-shaped ADTs that can render their structure. Like Validator, it is not
+// part of their public interfaces and must be reached by a type assertion.
+type Inspector interface {
+	// DOT renders the tree in the Graphviz DOT language.
+	DOT() string
+
+	// ASCII renders the tree as indented text, one node per line below its parent.
+	ASCII() string
+
+	// TreeStats returns the statistics of the shape of the tree.
+	TreeStats() TreeStats
+}
+
+// ===================== DOT ==========================
+
+// DOT renders the tree rooted at root in the Graphviz DOT language. When a node has a single child, an invisible
+// sibling is added so that the child is drawn on its own side.
+func DOT(root *TreeNode) string {
+	var builder strings.Builder
+	builder.WriteString("digraph {\n\tnode [shape=box];\n")
+	ids := 0
+	var write func(node *TreeNode) int
+	write = func(node *TreeNode) int {
+		id := ids
+		ids++
+		fmt.Fprintf(&builder, "\tn%d [label=\"%s\"];\n", id, escapeDOT(node.Label))
+		if node.Left == nil && node.Right == nil {
+			return id
+		}
+		for _, child := range []*TreeNode{node.Left, node.Right} {
+			if child == nil {
+				fmt.Fprintf(&builder, "\tn%d [style=invis];\n\tn%d -> n%d [style=invis];\n", ids, id, ids)
+				ids++
+				continue
+			}
+			childID := write(child)
+			fmt.Fprintf(&builder, "\tn%d -> n%d;\n", id, childID)
+		}
+		return id
+	}
+	if root != nil {
+		write(root)
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func escapeDOT(label string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(label)
+}
+
+// ===================== ASCII ==========================
+
+// ASCII renders the tree rooted at root as indented text. The children of a node are listed below it, the
+// left one first; when a node has a single child, the missing one is shown as nil.
+func ASCII(root *TreeNode) string {
+	if root == nil {
+		return "(empty)\n"
+	}
+	var builder strings.Builder
+	var write func(node *TreeNode, prefix string)
+	write = func(node *TreeNode, prefix string) {
+		if node.Left == nil && node.Right == nil {
+			return
+		}
+		children := []*TreeNode{node.Left, node.Right}
+		for i, child := range children {
+			branch, indent := "|-- ", "|   "
+			if i == len(children)-1 {
+				branch, indent = "`-- ", "    "
+			}
+			if child == nil {
+				builder.WriteString(prefix + branch + "nil\n")
+				continue
+			}
+			builder.WriteString(prefix + branch + child.Label + "\n")
+			write(child, prefix+indent)
+		}
+	}
+	builder.WriteString(root.Label + "\n")
+	write(root, "")
+	return builder.String()
+}
+
+// ===================== Stats ==========================
+
+// Stats computes the statistics of the shape of the tree rooted at root.
+func Stats(root *TreeNode) TreeStats {
+	var stats TreeStats
+	totalDepth := 0
+	// height returns the height of the subtree rooted at node, whose depth is the given one
+	var height func(node *TreeNode, depth int) int
+	height = func(node *TreeNode, depth int) int {
+		if node == nil {
+			return 0
+		}
+		stats.Nodes++
+		totalDepth += depth
+		if node.Left == nil && node.Right == nil {
+			stats.Leaves++
+		}
+		left, right := height(node.Left, depth+1), height(node.Right, depth+1)
+		stats.MaxImbalance = max(stats.MaxImbalance, left-right, right-left)
+		return max(left, right) + 1
+	}
+	stats.Height = height(root, 0)
+	if stats.Nodes > 0 {
+		stats.AverageDepth = float64(totalDepth) / float64(stats.Nodes)
+	}
+	return stats
+}
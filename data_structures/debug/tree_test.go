@@ -0,0 +1,71 @@
+package debug_test
+
+import (
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+
+	"github.com/stretchr/testify/require"
+)
+
+// sampleTree returns the tree
+//
+//	  b
+//	 / \
+//	a   d
+//	   /
+//	  c
+func sampleTree() *debug.TreeNode {
+	return &debug.TreeNode{
+		Label: "b",
+		Left:  &debug.TreeNode{Label: "a"},
+		Right: &debug.TreeNode{Label: "d", Left: &debug.TreeNode{Label: `"c"`}},
+	}
+}
+
+func TestTreeASCII(t *testing.T) {
+	require.Equal(t, "(empty)\n", debug.ASCII(nil))
+	require.Equal(t, "b\n"+
+		"|-- a\n"+
+		"`-- d\n"+
+		"    |-- \"c\"\n"+
+		"    `-- nil\n", debug.ASCII(sampleTree()))
+}
+
+func TestTreeDOT(t *testing.T) {
+	require.Equal(t, "digraph {\n\tnode [shape=box];\n}\n", debug.DOT(nil))
+	require.Equal(t, "digraph {\n"+
+		"\tnode [shape=box];\n"+
+		"\tn0 [label=\"b\"];\n"+
+		"\tn1 [label=\"a\"];\n"+
+		"\tn0 -> n1;\n"+
+		"\tn2 [label=\"d\"];\n"+
+		"\tn3 [label=\"\\\"c\\\"\"];\n"+
+		"\tn2 -> n3;\n"+
+		"\tn4 [style=invis];\n"+
+		"\tn2 -> n4 [style=invis];\n"+
+		"\tn0 -> n2;\n"+
+		"}\n", debug.DOT(sampleTree()))
+}
+
+func TestTreeStats(t *testing.T) {
+	require.Equal(t, debug.TreeStats{}, debug.Stats(nil))
+	require.Equal(t, debug.TreeStats{
+		Nodes:        4,
+		Height:       3,
+		Leaves:       2,
+		AverageDepth: 1,
+		MaxImbalance: 1,
+	}, debug.Stats(sampleTree()))
+
+	// A degenerate tree, where every node only has a right child
+	var root *debug.TreeNode
+	for i := 0; i < 5; i++ {
+		root = &debug.TreeNode{Label: "x", Right: root}
+	}
+	stats := debug.Stats(root)
+	require.Equal(t, 5, stats.Height)
+	require.Equal(t, 1, stats.Leaves)
+	require.Equal(t, 4, stats.MaxImbalance)
+	require.Equal(t, 2.0, stats.AverageDepth)
+}
@@ -0,0 +1,51 @@
+// Package arena allocates the nodes of the linked ADTs in slabs, slices of nodes that are contiguous in memory,
+// so that a structure with millions of nodes makes a few large allocations instead of one per node. The nodes
+// released by the structure are kept in a free list, threaded through their own links, and handed out again
+// before a new slab is allocated. The nodes are still linked by pointers, so a slab is kept in memory as long
+// as any of its nodes is.
+package arena
+
+const (
+	_FIRST_SLAB = 16
+	_MAX_SLAB   = 4096
+)
+
+// Arena hands out nodes of type T. Like the structures that use it, it must not be used from several
+// goroutines at the same time.
+type Arena[T any] struct {
+	// slab is the slab being filled, whose length is the number of nodes handed out from it
+	slab []T
+	// free is the first node of the free list, which continues through the link of each node
+	free *T
+	link func(node *T) **T
+}
+
+// New creates an empty arena of nodes. link returns the address of a pointer field of the node, such as its
+// next node, where the arena keeps the free list while the node is not in use.
+func New[T any](link func(node *T) **T) *Arena[T] {
+	return &Arena[T]{link: link}
+}
+
+// Alloc returns a node set to the zero value of T. The slabs double in size, up to a limit, so that small
+// structures do not reserve much more memory than they use.
+func (arena *Arena[T]) Alloc() *T {
+	if node := arena.free; node != nil {
+		link := arena.link(node)
+		arena.free, *link = *link, nil
+		return node
+	}
+	if len(arena.slab) == cap(arena.slab) {
+		arena.slab = make([]T, 0, min(max(2*cap(arena.slab), _FIRST_SLAB), _MAX_SLAB))
+	}
+	arena.slab = arena.slab[:len(arena.slab)+1]
+	return &arena.slab[len(arena.slab)-1]
+}
+
+// Free returns the node to the arena, which will hand it out again. The node is cleared, so that it does not
+// keep alive the values it referenced, and must not be used afterwards.
+func (arena *Arena[T]) Free(node *T) {
+	var zero T
+	*node = zero
+	*arena.link(node) = arena.free
+	arena.free = node
+}
@@ -0,0 +1,53 @@
+package arena_test
+
+import (
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/arena"
+
+	"github.com/stretchr/testify/require"
+)
+
+type node struct {
+	value int
+	next  *node
+}
+
+func TestArenaHandsOutDistinctNodes(t *testing.T) {
+	nodes := arena.New(func(n *node) **node { return &n.next })
+	allocated := make([]*node, 10000)
+	for i := range allocated {
+		allocated[i] = nodes.Alloc()
+		require.Zero(t, *allocated[i])
+		allocated[i].value = i
+	}
+	for i, node := range allocated {
+		require.Equal(t, i, node.value, "A node should not be handed out twice while in use")
+	}
+}
+
+func TestArenaReusesFreedNodes(t *testing.T) {
+	nodes := arena.New(func(n *node) **node { return &n.next })
+	first, second, third := nodes.Alloc(), nodes.Alloc(), nodes.Alloc()
+	first.value, first.next = 1, third
+	second.value = 2
+	nodes.Free(first)
+	nodes.Free(second)
+	require.Zero(t, first.value, "A freed node should be cleared")
+
+	require.Same(t, second, nodes.Alloc(), "The freed nodes should be handed out before growing")
+	reused := nodes.Alloc()
+	require.Same(t, first, reused)
+	require.Zero(t, *reused, "A node should be handed out cleared, without the link of the free list")
+	require.NotSame(t, third, nodes.Alloc())
+}
+
+func TestArenaAllocatesInSlabs(t *testing.T) {
+	nodes := arena.New(func(n *node) **node { return &n.next })
+	allocations := testing.AllocsPerRun(10, func() {
+		for i := 0; i < 4096; i++ {
+			nodes.Alloc()
+		}
+	})
+	require.Less(t, allocations, 10.0, "Thousands of nodes should take a handful of allocations")
+}
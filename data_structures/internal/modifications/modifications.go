@@ -0,0 +1,45 @@
+// Package modifications makes the iterators of the maps and lists of this module fail fast. A structure counts
+// the changes to its shape, that is, the elements added, removed or reordered, and each iterator remembers the
+// count it started from. Updating the value of an element does not count, since it never invalidates an iterator.
+package modifications
+
+// Counter counts the modifications of a structure. Its zero value is ready to use.
+type Counter struct {
+	count int
+}
+
+// Modified records a modification.
+func (counter *Counter) Modified() {
+	counter.count++
+}
+
+// Replaces continues the count of a structure whose contents are being replaced, which is itself a modification.
+func (counter *Counter) Replaces(previous Counter) {
+	counter.count = previous.count + 1
+}
+
+// Check remembers the modification count of a structure when an iterator was created, and panics if the
+// structure is changed by anything other than the iterator itself, since the iterator could then skip elements,
+// visit them twice or be left at a position that no longer holds one.
+type Check struct {
+	counter  *Counter
+	expected int
+	message  string
+}
+
+// NewCheck returns a Check of the counter that panics with the message.
+func NewCheck(counter *Counter, message string) Check {
+	return Check{counter, counter.count, message}
+}
+
+// Verify panics if the structure was modified since the check was created or last synced.
+func (check *Check) Verify() {
+	if check.counter.count != check.expected {
+		panic(check.message)
+	}
+}
+
+// Sync accepts the modifications done so far, after the iterator changed the structure itself.
+func (check *Check) Sync() {
+	check.expected = check.counter.count
+}
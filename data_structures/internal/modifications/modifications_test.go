@@ -0,0 +1,34 @@
+package modifications_test
+
+import (
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+
+	"github.com/stretchr/testify/require"
+)
+
+const _MESSAGE = "The structure was modified while iterating"
+
+func TestCheckPanicsAfterModification(t *testing.T) {
+	var counter modifications.Counter
+	check := modifications.NewCheck(&counter, _MESSAGE)
+	require.NotPanics(t, check.Verify)
+
+	counter.Modified()
+	require.PanicsWithValue(t, _MESSAGE, check.Verify)
+
+	check.Sync()
+	require.NotPanics(t, check.Verify, "The modifications should be accepted once synced")
+}
+
+func TestReplacesCountsAsModification(t *testing.T) {
+	var previous, replaced modifications.Counter
+	previous.Modified()
+	check := modifications.NewCheck(&previous, _MESSAGE)
+
+	// A decoded structure takes over the count of the one it replaces
+	replaced.Replaces(previous)
+	previous = replaced
+	require.PanicsWithValue(t, _MESSAGE, check.Verify)
+}
@@ -0,0 +1,256 @@
+package interval_tree
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/avl"
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+)
+
+const (
+	_INTERVAL_NOT_FOUND = "The interval does not belong to the tree"
+	_INVALID_INTERVAL   = "The lower bound of the interval is greater than the upper bound"
+	_ITERATOR_FINISH    = "The iterator has finished iterating"
+)
+
+// ===================== Types ==========================
+
+// intervalNode is a node of an AVL tree ordered by (low, high). maxHigh is the greatest upper bound
+// of the subtree rooted at the node, which allows discarding whole subtrees while searching.
+type intervalNode[K any, V any] struct {
+	left    *intervalNode[K, V]
+	right   *intervalNode[K, V]
+	low     K
+	high    K
+	maxHigh K
+	value   V
+	height  int
+}
+
+type avlIntervalTree[K any, V any] struct {
+	root *intervalNode[K, V]
+	size int
+	cmp  func(K, K) int
+	// balancer rebalances the nodes of the tree, keeping their maximum upper bounds up to date
+	balancer avl.Balancer[intervalNode[K, V]]
+}
+
+// intervalIter visits in order the intervals that overlap [low, high]. The top of the stack is always
+// the current interval.
+type intervalIter[K any, V any] struct {
+	stack ADTStack.Stack[*intervalNode[K, V]]
+	low   K
+	high  K
+	tree  *avlIntervalTree[K, V]
+}
+
+// ===================== AVL Helpers ==========================
+
+func createNode[K any, V any](low K, high K, value V) *intervalNode[K, V] {
+	node := new(intervalNode[K, V])
+	node.low = low
+	node.high = high
+	node.maxHigh = high
+	node.value = value
+	node.height = 1
+	return node
+}
+
+// augment recomputes the maximum upper bound of the node from its children.
+func (tree *avlIntervalTree[K, V]) augment(node *intervalNode[K, V]) {
+	node.maxHigh = node.high
+	if node.left != nil && tree.cmp(node.left.maxHigh, node.maxHigh) > 0 {
+		node.maxHigh = node.left.maxHigh
+	}
+	if node.right != nil && tree.cmp(node.right.maxHigh, node.maxHigh) > 0 {
+		node.maxHigh = node.right.maxHigh
+	}
+}
+
+// compareInterval orders intervals by their lower bound and then by their upper bound.
+func (tree *avlIntervalTree[K, V]) compareInterval(node *intervalNode[K, V], low K, high K) int {
+	if compare := tree.cmp(node.low, low); compare != 0 {
+		return compare
+	}
+	return tree.cmp(node.high, high)
+}
+
+func (tree *avlIntervalTree[K, V]) findNode(low K, high K) *intervalNode[K, V] {
+	node := tree.root
+	for node != nil {
+		compare := tree.compareInterval(node, low, high)
+		if compare == 0 {
+			return node
+		}
+		if compare > 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return nil
+}
+
+func (tree *avlIntervalTree[K, V]) insertRec(node *intervalNode[K, V], low K, high K, value V) *intervalNode[K, V] {
+	if node == nil {
+		tree.size++
+		return createNode(low, high, value)
+	}
+	compare := tree.compareInterval(node, low, high)
+	if compare > 0 {
+		node.left = tree.insertRec(node.left, low, high, value)
+	} else if compare < 0 {
+		node.right = tree.insertRec(node.right, low, high, value)
+	} else {
+		node.value = value
+		return node
+	}
+	return tree.balancer.Rebalance(node)
+}
+
+func (tree *avlIntervalTree[K, V]) removeRec(node *intervalNode[K, V], low K, high K) *intervalNode[K, V] {
+	compare := tree.compareInterval(node, low, high)
+	if compare > 0 {
+		node.left = tree.removeRec(node.left, low, high)
+	} else if compare < 0 {
+		node.right = tree.removeRec(node.right, low, high)
+	} else {
+		if node.left == nil {
+			return node.right
+		}
+		if node.right == nil {
+			return node.left
+		}
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		node.low, node.high, node.value = successor.low, successor.high, successor.value
+		node.right = tree.removeRec(node.right, successor.low, successor.high)
+	}
+	return tree.balancer.Rebalance(node)
+}
+
+// ===================== CreateIntervalTree ==========================
+
+// CreateIntervalTree creates an interval tree whose bounds are compared with cmpFunc, which returns a negative
+// number if the first bound is lower than the second, a positive number if it is greater and 0 if they are equal.
+func CreateIntervalTree[K any, V any](cmpFunc func(K, K) int) IntervalTree[K, V] {
+	tree := new(avlIntervalTree[K, V])
+	tree.cmp = cmpFunc
+	tree.balancer = avl.Balancer[intervalNode[K, V]]{
+		Children: func(node *intervalNode[K, V]) (**intervalNode[K, V], **intervalNode[K, V]) {
+			return &node.left, &node.right
+		},
+		Height:  func(node *intervalNode[K, V]) *int { return &node.height },
+		Augment: tree.augment,
+	}
+	return tree
+}
+
+// ===================== Primitives ==========================
+
+func (tree *avlIntervalTree[K, V]) Insert(low K, high K, value V) {
+	if tree.cmp(low, high) > 0 {
+		panic(_INVALID_INTERVAL)
+	}
+	tree.root = tree.insertRec(tree.root, low, high, value)
+}
+
+func (tree *avlIntervalTree[K, V]) Contains(low K, high K) bool {
+	return tree.findNode(low, high) != nil
+}
+
+func (tree *avlIntervalTree[K, V]) Remove(low K, high K) V {
+	node := tree.findNode(low, high)
+	if node == nil {
+		panic(_INTERVAL_NOT_FOUND)
+	}
+	removed := node.value
+	tree.root = tree.removeRec(tree.root, low, high)
+	tree.size--
+	return removed
+}
+
+func (tree *avlIntervalTree[K, V]) Count() int {
+	return tree.size
+}
+
+// =================== Internal Iterator ===================
+
+func (tree *avlIntervalTree[K, V]) Iterate(visit func(low K, high K, value V) bool) {
+	stack := ADTStack.NewStack[*intervalNode[K, V]]()
+	for node := tree.root; node != nil || !stack.IsEmpty(); {
+		for node != nil {
+			stack.Push(node)
+			node = node.left
+		}
+		node = stack.Pop()
+		if !visit(node.low, node.high, node.value) {
+			return
+		}
+		node = node.right
+	}
+}
+
+// =================== External Iterators ===================
+
+func (tree *avlIntervalTree[K, V]) Stab(point K) IntervalIterator[K, V] {
+	return tree.Overlapping(point, point)
+}
+
+func (tree *avlIntervalTree[K, V]) Overlapping(low K, high K) IntervalIterator[K, V] {
+	iterator := new(intervalIter[K, V])
+	iterator.stack = ADTStack.NewStack[*intervalNode[K, V]]()
+	iterator.low = low
+	iterator.high = high
+	iterator.tree = tree
+	iterator.pushLeft(tree.root)
+	iterator.settle()
+	return iterator
+}
+
+// pushLeft stacks the node and its left descendants, skipping the subtrees whose upper bounds are all
+// lower than the beginning of the searched range.
+func (iterator *intervalIter[K, V]) pushLeft(node *intervalNode[K, V]) {
+	for node != nil && iterator.tree.cmp(node.maxHigh, iterator.low) >= 0 {
+		iterator.stack.Push(node)
+		node = node.left
+	}
+}
+
+// settle discards stacked intervals until the top one overlaps the searched range. Once an interval
+// starts after the end of the range, so do all the following ones, and the iteration finishes.
+func (iterator *intervalIter[K, V]) settle() {
+	for !iterator.stack.IsEmpty() {
+		top := iterator.stack.Peek()
+		if iterator.tree.cmp(top.low, iterator.high) > 0 {
+			iterator.stack = ADTStack.NewStack[*intervalNode[K, V]]()
+			return
+		}
+		if iterator.tree.cmp(top.high, iterator.low) >= 0 {
+			return
+		}
+		iterator.stack.Pop()
+		iterator.pushLeft(top.right)
+	}
+}
+
+func (iterator *intervalIter[K, V]) HasNext() bool {
+	return !iterator.stack.IsEmpty()
+}
+
+func (iterator *intervalIter[K, V]) Current() (K, K, V) {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	current := iterator.stack.Peek()
+	return current.low, current.high, current.value
+}
+
+func (iterator *intervalIter[K, V]) Next() {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	current := iterator.stack.Pop()
+	iterator.pushLeft(current.right)
+	iterator.settle()
+}
@@ -0,0 +1,41 @@
+package interval_tree
+
+type IntervalTree[K any, V any] interface {
+	// Insert stores the closed interval [low, high] with its associated value. If the interval is already present,
+	// the value is updated. If low is greater than high, it panics with the message
+	// "The lower bound of the interval is greater than the upper bound".
+	Insert(low K, high K, value V)
+
+	// Contains returns true if the interval [low, high] is stored in the tree, false otherwise.
+	Contains(low K, high K) bool
+
+	// Remove removes the interval [low, high] and returns its value. If the interval does not belong to the tree,
+	// it panics with the message "The interval does not belong to the tree".
+	Remove(low K, high K) V
+
+	// Count returns the number of intervals in the tree.
+	Count() int
+
+	// Iterate traverses the intervals ordered by their lower bound (and then by their upper bound),
+	// executing the "visit" function on each of them. If "visit" returns false, the iteration stops.
+	Iterate(visit func(low K, high K, value V) bool)
+
+	// Stab returns an iterator over the intervals that contain the given point.
+	Stab(point K) IntervalIterator[K, V]
+
+	// Overlapping returns an iterator over the intervals that share at least one point with [low, high].
+	Overlapping(low K, high K) IntervalIterator[K, V]
+}
+
+type IntervalIterator[K any, V any] interface {
+	// HasNext returns true if there are more intervals to see, false otherwise.
+	HasNext() bool
+
+	// Current returns the bounds and the value of the interval where the iterator is. If not HasNext,
+	// it panics with the message "The iterator has finished iterating".
+	Current() (K, K, V)
+
+	// Next advances to the next interval. If not HasNext, it panics with the message
+	// "The iterator has finished iterating".
+	Next()
+}
@@ -0,0 +1,125 @@
+package interval_tree_test
+
+import (
+	"math/rand"
+	"testing"
+
+	ADTIntervalTree "github.com/sebagarciad/algorithms-and-data-structures/interval_tree"
+
+	"github.com/stretchr/testify/require"
+)
+
+type interval struct {
+	low  int
+	high int
+}
+
+func cmpInt(a, b int) int {
+	return a - b
+}
+
+func collect(iter ADTIntervalTree.IntervalIterator[int, string]) []interval {
+	result := []interval{}
+	for ; iter.HasNext(); iter.Next() {
+		low, high, _ := iter.Current()
+		result = append(result, interval{low, high})
+	}
+	return result
+}
+
+func TestEmptyIntervalTree(t *testing.T) {
+	tree := ADTIntervalTree.CreateIntervalTree[int, string](cmpInt)
+	require.EqualValues(t, 0, tree.Count())
+	require.False(t, tree.Contains(1, 2))
+	require.PanicsWithValue(t, "The interval does not belong to the tree", func() { tree.Remove(1, 2) })
+
+	iter := tree.Stab(1)
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Current() })
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Next() })
+}
+
+func TestIntervalTreeInvalidInterval(t *testing.T) {
+	tree := ADTIntervalTree.CreateIntervalTree[int, string](cmpInt)
+	require.PanicsWithValue(t, "The lower bound of the interval is greater than the upper bound",
+		func() { tree.Insert(5, 1, "") })
+}
+
+func TestIntervalTreeInsertAndRemove(t *testing.T) {
+	tree := ADTIntervalTree.CreateIntervalTree[int, string](cmpInt)
+	tree.Insert(1, 5, "a")
+	tree.Insert(1, 3, "b")
+	tree.Insert(4, 4, "c")
+	require.EqualValues(t, 3, tree.Count())
+
+	tree.Insert(1, 5, "d")
+	require.EqualValues(t, 3, tree.Count(), "Inserting the same interval should update its value")
+
+	require.Equal(t, "d", tree.Remove(1, 5))
+	require.False(t, tree.Contains(1, 5))
+	require.True(t, tree.Contains(1, 3))
+	require.EqualValues(t, 2, tree.Count())
+
+	intervals := []interval{}
+	tree.Iterate(func(low int, high int, _ string) bool {
+		intervals = append(intervals, interval{low, high})
+		return true
+	})
+	require.Equal(t, []interval{{1, 3}, {4, 4}}, intervals)
+}
+
+func TestIntervalTreeStabAndOverlapping(t *testing.T) {
+	tree := ADTIntervalTree.CreateIntervalTree[int, string](cmpInt)
+	tree.Insert(15, 20, "")
+	tree.Insert(10, 30, "")
+	tree.Insert(17, 19, "")
+	tree.Insert(5, 20, "")
+	tree.Insert(12, 15, "")
+	tree.Insert(30, 40, "")
+
+	require.Equal(t, []interval{{5, 20}, {10, 30}, {12, 15}, {15, 20}}, collect(tree.Stab(15)))
+	require.Equal(t, []interval{{10, 30}, {30, 40}}, collect(tree.Stab(30)))
+	require.Empty(t, collect(tree.Stab(41)))
+	require.Equal(t, []interval{{5, 20}, {10, 30}, {15, 20}, {17, 19}, {30, 40}}, collect(tree.Overlapping(18, 35)))
+	require.Equal(t, []interval{{5, 20}}, collect(tree.Overlapping(0, 9)))
+}
+
+func TestIntervalTreeVolume(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	tree := ADTIntervalTree.CreateIntervalTree[int, string](cmpInt)
+	stored := make(map[interval]bool)
+
+	for i := 0; i < 5000; i++ {
+		low := rng.Intn(1000)
+		current := interval{low, low + rng.Intn(50)}
+		if stored[current] && rng.Intn(2) == 0 {
+			tree.Remove(current.low, current.high)
+			delete(stored, current)
+		} else {
+			tree.Insert(current.low, current.high, "")
+			stored[current] = true
+		}
+	}
+	require.EqualValues(t, len(stored), tree.Count())
+
+	for i := 0; i < 200; i++ {
+		low := rng.Intn(1100)
+		high := low + rng.Intn(30)
+		found := collect(tree.Overlapping(low, high))
+
+		expected := 0
+		for current := range stored {
+			if current.low <= high && low <= current.high {
+				expected++
+			}
+		}
+		require.Len(t, found, expected)
+		for j, current := range found {
+			require.True(t, stored[current])
+			require.True(t, current.low <= high && low <= current.high)
+			if j > 0 {
+				require.True(t, found[j-1].low <= current.low, "The intervals should be visited in order")
+			}
+		}
+	}
+}
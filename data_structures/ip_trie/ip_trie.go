@@ -0,0 +1,40 @@
+package ip_trie
+
+import "net/netip"
+
+type IPTrie[V any] interface {
+	// Save stores the prefix (CIDR block) with its associated value. A single address is stored as a prefix
+	// with all its bits, e.g. 10.0.0.1/32. If the prefix is already present, the value is updated.
+	// The host bits of the prefix are ignored. If the prefix is not valid, it panics with the message
+	// "The prefix is not valid".
+	Save(prefix netip.Prefix, value V)
+
+	// Contains returns true if the prefix is stored in the trie, false otherwise.
+	Contains(prefix netip.Prefix) bool
+
+	// Get returns the value associated with the prefix. If the prefix does not belong to the trie, it panics
+	// with the message "The prefix does not belong to the trie".
+	Get(prefix netip.Prefix) V
+
+	// Remove removes the prefix from the trie and returns its value. If the prefix does not belong to the trie,
+	// it panics with the message "The prefix does not belong to the trie".
+	Remove(prefix netip.Prefix) V
+
+	// Count returns the number of prefixes stored in the trie.
+	Count() int
+
+	// LongestPrefixMatch returns the most specific stored prefix that contains the address, and its value.
+	// If no stored prefix contains it, the last result is false.
+	LongestPrefixMatch(addr netip.Addr) (netip.Prefix, V, bool)
+
+	// CountWithin returns the number of stored prefixes contained in the given prefix, including itself.
+	CountWithin(prefix netip.Prefix) int
+
+	// IterateWithin traverses in address order the stored prefixes contained in the given prefix, executing
+	// the "visit" function on each of them. A prefix is visited before the more specific ones it contains.
+	// If "visit" returns false, the iteration stops.
+	IterateWithin(prefix netip.Prefix, visit func(prefix netip.Prefix, value V) bool)
+
+	// Iterate traverses every stored prefix, the IPv4 ones first, in the same order as IterateWithin.
+	Iterate(visit func(prefix netip.Prefix, value V) bool)
+}
@@ -0,0 +1,298 @@
+package ip_trie
+
+import (
+	"math/bits"
+	"net/netip"
+
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+)
+
+const (
+	_PREFIX_NOT_FOUND = "The prefix does not belong to the trie"
+	_INVALID_PREFIX   = "The prefix is not valid"
+)
+
+// ===================== Types ==========================
+
+// trieNode is a node of a path-compressed binary trie. Its children hold the prefixes that extend its own
+// with a 0 or a 1 bit respectively. Nodes without value only exist to branch. count is the number of stored
+// prefixes in the subtree rooted at the node.
+type trieNode[V any] struct {
+	prefix   netip.Prefix
+	children [2]*trieNode[V]
+	value    V
+	hasValue bool
+	count    int
+}
+
+// patriciaTrie keeps IPv4 and IPv6 prefixes in separate tries, since their addresses have different lengths.
+type patriciaTrie[V any] struct {
+	rootIPv4 *trieNode[V]
+	rootIPv6 *trieNode[V]
+}
+
+// ===================== Bit Helpers ==========================
+
+// firstBit returns the position in the 16 bytes of As16 of the first bit of the address. An IPv4 address is
+// mapped to the last 4 of them.
+func firstBit(addr netip.Addr) int {
+	if addr.Is4() {
+		return 96
+	}
+	return 0
+}
+
+// bitAt returns the bit of the address at the given position, counting from the most significant one.
+func bitAt(addr netip.Addr, position int) int {
+	bytes := addr.As16()
+	position += firstBit(addr)
+	return int(bytes[position/8]>>(7-position%8)) & 1
+}
+
+// commonBits returns the number of leading bits shared by both prefixes, which must be of the same family, up
+// to the length of the shortest.
+func commonBits(a, b netip.Prefix) int {
+	limit := min(a.Bits(), b.Bits())
+	bytesA, bytesB := a.Addr().As16(), b.Addr().As16()
+	common := 0
+	for i := firstBit(a.Addr()) / 8; common < limit; i++ {
+		if diff := bytesA[i] ^ bytesB[i]; diff != 0 {
+			common += bits.LeadingZeros8(diff)
+			break
+		}
+		common += 8
+	}
+	return min(common, limit)
+}
+
+// contains returns true if the prefix inner is equal to or more specific than outer.
+func contains(outer, inner netip.Prefix) bool {
+	return outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+// ===================== Trie Helpers ==========================
+
+func normalize(prefix netip.Prefix) netip.Prefix {
+	if !prefix.IsValid() {
+		panic(_INVALID_PREFIX)
+	}
+	return prefix.Masked()
+}
+
+func (trie *patriciaTrie[V]) rootLink(addr netip.Addr) **trieNode[V] {
+	if addr.Is4() {
+		return &trie.rootIPv4
+	}
+	return &trie.rootIPv6
+}
+
+// findPath returns the links from the root to the node that holds the given prefix, or nil if there is none.
+func (trie *patriciaTrie[V]) findPath(prefix netip.Prefix) []**trieNode[V] {
+	link := trie.rootLink(prefix.Addr())
+	path := []**trieNode[V]{}
+	for *link != nil && contains((*link).prefix, prefix) {
+		path = append(path, link)
+		node := *link
+		if node.prefix.Bits() == prefix.Bits() {
+			return path
+		}
+		link = &node.children[bitAt(prefix.Addr(), node.prefix.Bits())]
+	}
+	return nil
+}
+
+func (trie *patriciaTrie[V]) findNode(prefix netip.Prefix) *trieNode[V] {
+	path := trie.findPath(normalize(prefix))
+	if path == nil || !(*path[len(path)-1]).hasValue {
+		return nil
+	}
+	return *path[len(path)-1]
+}
+
+// findWithin returns the highest node whose prefix is contained in the given one.
+func (trie *patriciaTrie[V]) findWithin(prefix netip.Prefix) *trieNode[V] {
+	node := *trie.rootLink(prefix.Addr())
+	for node != nil {
+		if contains(prefix, node.prefix) {
+			return node
+		}
+		if !contains(node.prefix, prefix) {
+			return nil
+		}
+		node = node.children[bitAt(prefix.Addr(), node.prefix.Bits())]
+	}
+	return nil
+}
+
+// ===================== CreateIPTrie ==========================
+
+// CreateIPTrie creates a trie of IPv4 and IPv6 prefixes where each bit of the address selects a branch,
+// and chains of nodes with a single child are compressed into one.
+func CreateIPTrie[V any]() IPTrie[V] {
+	return new(patriciaTrie[V])
+}
+
+// ===================== Save() ==========================
+
+func (trie *patriciaTrie[V]) Save(prefix netip.Prefix, value V) {
+	prefix = normalize(prefix)
+	if node := trie.findNode(prefix); node != nil {
+		node.value = value
+		return
+	}
+
+	leaf := &trieNode[V]{prefix: prefix, value: value, hasValue: true, count: 1}
+	link := trie.rootLink(prefix.Addr())
+	for {
+		node := *link
+		if node == nil {
+			*link = leaf
+			return
+		}
+
+		common := commonBits(node.prefix, prefix)
+		switch {
+		case common == node.prefix.Bits() && common == prefix.Bits():
+			// The node was only branching, and now holds the prefix
+			node.value, node.hasValue = value, true
+			node.count++
+			return
+		case common == node.prefix.Bits():
+			node.count++
+			link = &node.children[bitAt(prefix.Addr(), common)]
+		case common == prefix.Bits():
+			leaf.children[bitAt(node.prefix.Addr(), common)] = node
+			leaf.count += node.count
+			*link = leaf
+			return
+		default:
+			branch := &trieNode[V]{prefix: netip.PrefixFrom(prefix.Addr(), common).Masked(), count: node.count + 1}
+			branch.children[bitAt(prefix.Addr(), common)] = leaf
+			branch.children[bitAt(node.prefix.Addr(), common)] = node
+			*link = branch
+			return
+		}
+	}
+}
+
+// ===================== Contains() and Get() ==========================
+
+func (trie *patriciaTrie[V]) Contains(prefix netip.Prefix) bool {
+	return trie.findNode(prefix) != nil
+}
+
+func (trie *patriciaTrie[V]) Get(prefix netip.Prefix) V {
+	node := trie.findNode(prefix)
+	if node == nil {
+		panic(_PREFIX_NOT_FOUND)
+	}
+	return node.value
+}
+
+// ===================== Remove() ==========================
+
+// Remove clears the value of the node and then removes the nodes left without purpose: a node without value
+// is removed if it has no children, and replaced by its child if it has only one.
+func (trie *patriciaTrie[V]) Remove(prefix netip.Prefix) V {
+	path := trie.findPath(normalize(prefix))
+	if path == nil || !(*path[len(path)-1]).hasValue {
+		panic(_PREFIX_NOT_FOUND)
+	}
+	node := *path[len(path)-1]
+	removed := node.value
+	var zero V
+	node.value, node.hasValue = zero, false
+	for _, link := range path {
+		(*link).count--
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		current := *path[i]
+		if current.hasValue || (current.children[0] != nil && current.children[1] != nil) {
+			break
+		}
+		if current.children[0] != nil {
+			*path[i] = current.children[0]
+		} else {
+			*path[i] = current.children[1]
+		}
+	}
+	return removed
+}
+
+// ===================== Count() ==========================
+
+func (trie *patriciaTrie[V]) Count() int {
+	count := 0
+	if trie.rootIPv4 != nil {
+		count += trie.rootIPv4.count
+	}
+	if trie.rootIPv6 != nil {
+		count += trie.rootIPv6.count
+	}
+	return count
+}
+
+// ===================== Prefix Queries ==========================
+
+func (trie *patriciaTrie[V]) LongestPrefixMatch(addr netip.Addr) (netip.Prefix, V, bool) {
+	var best *trieNode[V]
+	node := *trie.rootLink(addr)
+	for node != nil && node.prefix.Contains(addr) {
+		if node.hasValue {
+			best = node
+		}
+		if node.prefix.Bits() == addr.BitLen() {
+			break
+		}
+		node = node.children[bitAt(addr, node.prefix.Bits())]
+	}
+
+	if best == nil {
+		var zero V
+		return netip.Prefix{}, zero, false
+	}
+	return best.prefix, best.value, true
+}
+
+func (trie *patriciaTrie[V]) CountWithin(prefix netip.Prefix) int {
+	node := trie.findWithin(normalize(prefix))
+	if node == nil {
+		return 0
+	}
+	return node.count
+}
+
+// =================== Internal Iterator ===================
+
+func (trie *patriciaTrie[V]) IterateWithin(prefix netip.Prefix, visit func(prefix netip.Prefix, value V) bool) {
+	iterateSubtree(trie.findWithin(normalize(prefix)), visit)
+}
+
+func (trie *patriciaTrie[V]) Iterate(visit func(prefix netip.Prefix, value V) bool) {
+	if iterateSubtree(trie.rootIPv4, visit) {
+		iterateSubtree(trie.rootIPv6, visit)
+	}
+}
+
+// iterateSubtree visits the nodes in preorder, the 0 branch before the 1 branch, which sorts the prefixes by
+// address and then by length. It returns false if the iteration was cut by visit.
+func iterateSubtree[V any](root *trieNode[V], visit func(prefix netip.Prefix, value V) bool) bool {
+	if root == nil {
+		return true
+	}
+	stack := ADTStack.NewStack[*trieNode[V]]()
+	stack.Push(root)
+	for !stack.IsEmpty() {
+		node := stack.Pop()
+		if node.hasValue && !visit(node.prefix, node.value) {
+			return false
+		}
+		for i := 1; i >= 0; i-- {
+			if node.children[i] != nil {
+				stack.Push(node.children[i])
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,189 @@
+package ip_trie_test
+
+import (
+	"math/rand"
+	"net/netip"
+	"testing"
+
+	ADTIPTrie "github.com/sebagarciad/algorithms-and-data-structures/ip_trie"
+
+	"github.com/stretchr/testify/require"
+)
+
+var _BLOCKS = []string{
+	"10.0.0.0/8",
+	"10.1.0.0/16",
+	"10.1.2.0/24",
+	"10.1.2.3/32",
+	"10.128.0.0/9",
+	"192.168.0.0/16",
+	"2001:db8::/32",
+	"2001:db8:1::/48",
+}
+
+func prefixesWithin(trie ADTIPTrie.IPTrie[int], prefix netip.Prefix) []string {
+	prefixes := []string{}
+	trie.IterateWithin(prefix, func(prefix netip.Prefix, _ int) bool {
+		prefixes = append(prefixes, prefix.String())
+		return true
+	})
+	return prefixes
+}
+
+func TestIPTrieEmpty(t *testing.T) {
+	trie := ADTIPTrie.CreateIPTrie[int]()
+	require.EqualValues(t, 0, trie.Count())
+	require.False(t, trie.Contains(netip.MustParsePrefix("0.0.0.0/0")))
+	require.PanicsWithValue(t, "The prefix does not belong to the trie",
+		func() { trie.Get(netip.MustParsePrefix("10.0.0.0/8")) })
+	require.PanicsWithValue(t, "The prefix does not belong to the trie",
+		func() { trie.Remove(netip.MustParsePrefix("::/0")) })
+	require.PanicsWithValue(t, "The prefix is not valid", func() { trie.Save(netip.Prefix{}, 0) })
+	_, _, found := trie.LongestPrefixMatch(netip.MustParseAddr("10.0.0.1"))
+	require.False(t, found)
+	require.EqualValues(t, 0, trie.CountWithin(netip.MustParsePrefix("0.0.0.0/0")))
+}
+
+func TestIPTrieSaveAndGet(t *testing.T) {
+	trie := ADTIPTrie.CreateIPTrie[int]()
+	for i, block := range _BLOCKS {
+		trie.Save(netip.MustParsePrefix(block), i)
+	}
+	require.EqualValues(t, len(_BLOCKS), trie.Count())
+	for i, block := range _BLOCKS {
+		require.True(t, trie.Contains(netip.MustParsePrefix(block)))
+		require.Equal(t, i, trie.Get(netip.MustParsePrefix(block)))
+	}
+	require.False(t, trie.Contains(netip.MustParsePrefix("10.1.0.0/15")))
+	require.False(t, trie.Contains(netip.MustParsePrefix("10.0.0.0/32")))
+
+	require.True(t, trie.Contains(netip.MustParsePrefix("10.1.2.77/24")), "The host bits should be ignored")
+	trie.Save(netip.MustParsePrefix("10.1.2.77/24"), 100)
+	require.EqualValues(t, len(_BLOCKS), trie.Count())
+	require.Equal(t, 100, trie.Get(netip.MustParsePrefix("10.1.2.0/24")))
+
+	require.Equal(t, 100, trie.Remove(netip.MustParsePrefix("10.1.2.0/24")))
+	require.False(t, trie.Contains(netip.MustParsePrefix("10.1.2.0/24")))
+	require.True(t, trie.Contains(netip.MustParsePrefix("10.1.2.3/32")))
+	require.EqualValues(t, len(_BLOCKS)-1, trie.Count())
+}
+
+func TestIPTriePrefixQueries(t *testing.T) {
+	trie := ADTIPTrie.CreateIPTrie[int]()
+	for i, block := range _BLOCKS {
+		trie.Save(netip.MustParsePrefix(block), i)
+	}
+
+	prefix, value, found := trie.LongestPrefixMatch(netip.MustParseAddr("10.1.2.4"))
+	require.True(t, found)
+	require.Equal(t, "10.1.2.0/24", prefix.String())
+	require.Equal(t, 2, value)
+
+	prefix, _, _ = trie.LongestPrefixMatch(netip.MustParseAddr("10.1.2.3"))
+	require.Equal(t, "10.1.2.3/32", prefix.String())
+	prefix, _, _ = trie.LongestPrefixMatch(netip.MustParseAddr("10.200.0.1"))
+	require.Equal(t, "10.128.0.0/9", prefix.String())
+	prefix, _, _ = trie.LongestPrefixMatch(netip.MustParseAddr("2001:db8:1::5"))
+	require.Equal(t, "2001:db8:1::/48", prefix.String())
+	_, _, found = trie.LongestPrefixMatch(netip.MustParseAddr("11.0.0.1"))
+	require.False(t, found)
+	_, _, found = trie.LongestPrefixMatch(netip.MustParseAddr("::ffff:10.1.2.3"))
+	require.False(t, found, "IPv4-mapped addresses belong to the IPv6 family")
+
+	require.Equal(t, []string{"10.0.0.0/8", "10.1.0.0/16", "10.1.2.0/24", "10.1.2.3/32", "10.128.0.0/9"},
+		prefixesWithin(trie, netip.MustParsePrefix("10.0.0.0/8")))
+	require.Equal(t, []string{"10.1.0.0/16", "10.1.2.0/24", "10.1.2.3/32"},
+		prefixesWithin(trie, netip.MustParsePrefix("10.0.0.0/9")))
+	require.Empty(t, prefixesWithin(trie, netip.MustParsePrefix("172.16.0.0/12")))
+	require.EqualValues(t, 6, trie.CountWithin(netip.MustParsePrefix("0.0.0.0/0")))
+	require.EqualValues(t, 2, trie.CountWithin(netip.MustParsePrefix("10.1.2.0/24")))
+	require.EqualValues(t, 1, trie.CountWithin(netip.MustParsePrefix("10.1.2.3/32")))
+	require.EqualValues(t, 2, trie.CountWithin(netip.MustParsePrefix("::/0")))
+
+	prefixes := []string{}
+	trie.Iterate(func(prefix netip.Prefix, _ int) bool {
+		prefixes = append(prefixes, prefix.String())
+		return len(prefixes) < 7
+	})
+	require.Equal(t, []string{"10.0.0.0/8", "10.1.0.0/16", "10.1.2.0/24", "10.1.2.3/32", "10.128.0.0/9",
+		"192.168.0.0/16", "2001:db8::/32"}, prefixes)
+}
+
+func TestIPTrieVolume(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	trie := ADTIPTrie.CreateIPTrie[int]()
+	stored := make(map[netip.Prefix]int)
+
+	// Addresses are drawn from 10.0.0.0/22 so that the prefixes share long paths
+	randomPrefix := func() netip.Prefix {
+		addr := netip.AddrFrom4([4]byte{10, 0, byte(rng.Intn(4)), byte(rng.Intn(256))})
+		return netip.PrefixFrom(addr, 22+rng.Intn(11)).Masked()
+	}
+
+	for i := 0; i < 20000; i++ {
+		prefix := randomPrefix()
+		if _, ok := stored[prefix]; ok && rng.Intn(2) == 0 {
+			require.Equal(t, stored[prefix], trie.Remove(prefix))
+			delete(stored, prefix)
+		} else {
+			trie.Save(prefix, i)
+			stored[prefix] = i
+		}
+		require.EqualValues(t, len(stored), trie.Count())
+	}
+
+	for range 200 {
+		addr := netip.AddrFrom4([4]byte{10, 0, byte(rng.Intn(4)), byte(rng.Intn(256))})
+		var longest netip.Prefix
+		for prefix := range stored {
+			if prefix.Contains(addr) && prefix.Bits() > longest.Bits() {
+				longest = prefix
+			}
+		}
+		prefix, value, found := trie.LongestPrefixMatch(addr)
+		require.Equal(t, longest.IsValid(), found)
+		if found {
+			require.Equal(t, longest, prefix)
+			require.Equal(t, stored[longest], value)
+		}
+
+		within := randomPrefix()
+		expected := 0
+		for prefix := range stored {
+			if within.Bits() <= prefix.Bits() && within.Contains(prefix.Addr()) {
+				expected++
+			}
+		}
+		require.EqualValues(t, expected, trie.CountWithin(within))
+		require.Len(t, prefixesWithin(trie, within), expected)
+	}
+
+	var previous netip.Prefix
+	trie.Iterate(func(prefix netip.Prefix, value int) bool {
+		require.Equal(t, stored[prefix], value)
+		if previous.IsValid() {
+			order := previous.Addr().Compare(prefix.Addr())
+			require.True(t, order < 0 || (order == 0 && previous.Bits() < prefix.Bits()),
+				"The prefixes should be iterated by address and then by length")
+		}
+		previous = prefix
+		return true
+	})
+
+	for prefix := range stored {
+		trie.Remove(prefix)
+	}
+	require.EqualValues(t, 0, trie.Count())
+}
+
+func TestIPTrieLookupDoesNotAllocate(t *testing.T) {
+	trie := ADTIPTrie.CreateIPTrie[int]()
+	for i, block := range _BLOCKS {
+		trie.Save(netip.MustParsePrefix(block), i)
+	}
+	for _, addr := range []string{"10.1.2.4", "2001:db8:1::5"} {
+		addr := netip.MustParseAddr(addr)
+		allocs := testing.AllocsPerRun(100, func() { trie.LongestPrefixMatch(addr) })
+		require.Zero(t, allocs, "Reading the bits of %s should not copy it to the heap", addr)
+	}
+}
@@ -0,0 +1,225 @@
+package linked_list
+
+import "github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+
+const _FOREIGN_NODE = "The node does not belong to the list"
+
+// Node is an element of a DoublyLinkedList. Its value can be read and updated directly, while its links are
+// only changed by the list it belongs to.
+type Node[T any] struct {
+	Value    T
+	previous *Node[T]
+	next     *Node[T]
+	list     *doublyLinkedList[T]
+}
+
+type doublyLinkedList[T any] struct {
+	first         *Node[T]
+	last          *Node[T]
+	length        int
+	modifications modifications.Counter
+}
+
+type doublyLinkedListIterator[T any] struct {
+	current       *Node[T]
+	list          *doublyLinkedList[T]
+	modifications modifications.Check
+}
+
+// Next returns the node that follows this one in its list, or nil if it is the last one.
+func (node *Node[T]) Next() *Node[T] {
+	return node.next
+}
+
+// Previous returns the node that precedes this one in its list, or nil if it is the first one.
+func (node *Node[T]) Previous() *Node[T] {
+	return node.previous
+}
+
+// Doubly linked list helpers
+
+func (list *doublyLinkedList[T]) checkOwner(node *Node[T]) {
+	if node == nil || node.list != list {
+		panic(_FOREIGN_NODE)
+	}
+}
+
+// link inserts the node between previous and next, either of which may be nil at the ends of the list.
+func (list *doublyLinkedList[T]) link(node, previous, next *Node[T]) {
+	node.list = list
+	node.previous = previous
+	node.next = next
+	if previous == nil {
+		list.first = node
+	} else {
+		previous.next = node
+	}
+	if next == nil {
+		list.last = node
+	} else {
+		next.previous = node
+	}
+	list.length++
+	list.modifications.Modified()
+}
+
+func (list *doublyLinkedList[T]) unlink(node *Node[T]) {
+	if node.previous == nil {
+		list.first = node.next
+	} else {
+		node.previous.next = node.next
+	}
+	if node.next == nil {
+		list.last = node.previous
+	} else {
+		node.next.previous = node.previous
+	}
+	node.previous, node.next, node.list = nil, nil, nil
+	list.length--
+	list.modifications.Modified()
+}
+
+// List primitives
+
+func NewDoublyLinkedList[T any]() DoublyLinkedList[T] {
+	return new(doublyLinkedList[T])
+}
+
+func (list *doublyLinkedList[T]) IsEmpty() bool {
+	return list.length == 0
+}
+
+func (list *doublyLinkedList[T]) InsertFirst(data T) {
+	list.PushFirst(data)
+}
+
+func (list *doublyLinkedList[T]) InsertLast(data T) {
+	list.PushLast(data)
+}
+
+func (list *doublyLinkedList[T]) PushFirst(data T) *Node[T] {
+	node := &Node[T]{Value: data}
+	list.link(node, nil, list.first)
+	return node
+}
+
+func (list *doublyLinkedList[T]) PushLast(data T) *Node[T] {
+	node := &Node[T]{Value: data}
+	list.link(node, list.last, nil)
+	return node
+}
+
+func (list *doublyLinkedList[T]) InsertAfter(previous *Node[T], data T) *Node[T] {
+	list.checkOwner(previous)
+	node := &Node[T]{Value: data}
+	list.link(node, previous, previous.next)
+	return node
+}
+
+func (list *doublyLinkedList[T]) DeleteFirst() T {
+	if list.IsEmpty() {
+		panic(_EMPTY_LIST_MESSAGE)
+	}
+	return list.Remove(list.first)
+}
+
+func (list *doublyLinkedList[T]) Remove(node *Node[T]) T {
+	list.checkOwner(node)
+	list.unlink(node)
+	return node.Value
+}
+
+func (list *doublyLinkedList[T]) MoveToFirst(node *Node[T]) {
+	list.checkOwner(node)
+	if node == list.first {
+		return
+	}
+	list.unlink(node)
+	list.link(node, nil, list.first)
+}
+
+func (list *doublyLinkedList[T]) SeeFirst() T {
+	if list.IsEmpty() {
+		panic(_EMPTY_LIST_MESSAGE)
+	}
+	return list.first.Value
+}
+
+func (list *doublyLinkedList[T]) SeeLast() T {
+	if list.IsEmpty() {
+		panic(_EMPTY_LIST_MESSAGE)
+	}
+	return list.last.Value
+}
+
+func (list *doublyLinkedList[T]) FirstNode() *Node[T] {
+	return list.first
+}
+
+func (list *doublyLinkedList[T]) LastNode() *Node[T] {
+	return list.last
+}
+
+func (list *doublyLinkedList[T]) Length() int {
+	return list.length
+}
+
+// Internal iterator
+
+func (list *doublyLinkedList[T]) Iterate(visit func(T) bool) {
+	for current := list.first; current != nil; current = current.next {
+		if !visit(current.Value) {
+			break
+		}
+	}
+}
+
+// External iterator primitives
+
+func (list *doublyLinkedList[T]) Iterator() ListIterator[T] {
+	iterator := new(doublyLinkedListIterator[T])
+	iterator.current = list.first
+	iterator.list = list
+	iterator.modifications = modifications.NewCheck(&list.modifications, _CONCURRENT_MODIFICATION)
+	return iterator
+}
+
+func (iterator *doublyLinkedListIterator[T]) HasNext() bool {
+	iterator.modifications.Verify()
+	return iterator.current != nil
+}
+
+func (iterator *doublyLinkedListIterator[T]) SeeCurrent() T {
+	if !iterator.HasNext() {
+		panic(_END_OF_ITERATION)
+	}
+	return iterator.current.Value
+}
+
+func (iterator *doublyLinkedListIterator[T]) Next() {
+	if !iterator.HasNext() {
+		panic(_END_OF_ITERATION)
+	}
+	iterator.current = iterator.current.next
+}
+
+func (iterator *doublyLinkedListIterator[T]) Insert(data T) {
+	iterator.modifications.Verify()
+	if iterator.current == nil {
+		iterator.current = iterator.list.PushLast(data)
+	} else {
+		node := &Node[T]{Value: data}
+		iterator.list.link(node, iterator.current.previous, iterator.current)
+		iterator.current = node
+	}
+	iterator.modifications.Sync()
+}
+
+func (iterator *doublyLinkedListIterator[T]) Delete() T {
+	data := iterator.SeeCurrent()
+	next := iterator.current.next
+	iterator.list.unlink(iterator.current)
+	iterator.modifications.Sync()
+	iterator.current = next
+	return data
+}
@@ -0,0 +1,299 @@
+package linked_list_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+	ADTList "github.com/sebagarciad/algorithms-and-data-structures/linked_list"
+	"github.com/sebagarciad/algorithms-and-data-structures/linked_list/listtest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func listElements[T any](list ADTList.List[T]) []T {
+	elements := []T{}
+	list.Iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	return elements
+}
+
+func TestDoublyLinkedListEmpty(t *testing.T) {
+	list := ADTList.NewDoublyLinkedList[int]()
+	require.True(t, list.IsEmpty())
+	require.Nil(t, list.FirstNode())
+	require.Nil(t, list.LastNode())
+	require.PanicsWithValue(t, "The list is empty", func() { list.DeleteFirst() })
+	require.PanicsWithValue(t, "The list is empty", func() { list.SeeFirst() })
+	require.PanicsWithValue(t, "The list is empty", func() { list.SeeLast() })
+	require.False(t, list.Iterator().HasNext())
+}
+
+func TestDoublyLinkedListNodes(t *testing.T) {
+	list := ADTList.NewDoublyLinkedList[int]()
+	two := list.PushFirst(2)
+	one := list.PushFirst(1)
+	four := list.PushLast(4)
+	three := list.InsertAfter(two, 3)
+	require.Equal(t, []int{1, 2, 3, 4}, listElements[int](list))
+	require.Equal(t, one, list.FirstNode())
+	require.Equal(t, four, list.LastNode())
+	require.Equal(t, three, two.Next())
+	require.Equal(t, two, three.Previous())
+
+	list.MoveToFirst(three)
+	require.Equal(t, []int{3, 1, 2, 4}, listElements[int](list))
+	list.MoveToFirst(four)
+	require.Equal(t, []int{4, 3, 1, 2}, listElements[int](list))
+	require.Equal(t, 2, list.SeeLast())
+
+	require.Equal(t, 1, list.Remove(one))
+	require.Equal(t, 2, list.Remove(two))
+	require.Equal(t, []int{4, 3}, listElements[int](list))
+	require.Equal(t, 2, list.Length())
+	require.PanicsWithValue(t, "The node does not belong to the list", func() { list.Remove(one) })
+	require.PanicsWithValue(t, "The node does not belong to the list",
+		func() { ADTList.NewDoublyLinkedList[int]().MoveToFirst(three) })
+
+	four.Value = 40
+	require.Equal(t, 40, list.DeleteFirst())
+	require.Equal(t, 3, list.DeleteFirst())
+	require.True(t, list.IsEmpty())
+}
+
+func TestDoublyLinkedListIterator(t *testing.T) {
+	list := ADTList.NewDoublyLinkedList[int]()
+	iter := list.Iterator()
+	iter.Insert(3)
+	iter.Insert(1)
+	iter.Next()
+	iter.Insert(2)
+	for iter.HasNext() {
+		iter.Next()
+	}
+	iter.Insert(4)
+	require.Equal(t, []int{1, 2, 3, 4}, listElements[int](list))
+	require.Equal(t, 4, list.SeeLast())
+
+	iter = list.Iterator()
+	iter.Next()
+	require.Equal(t, 2, iter.Delete())
+	require.Equal(t, 3, iter.SeeCurrent())
+	iter.Next()
+	require.Equal(t, 4, iter.Delete())
+	require.False(t, iter.HasNext())
+	require.Equal(t, []int{1, 3}, listElements[int](list))
+	require.Equal(t, 3, list.SeeLast())
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Delete() })
+}
+
+func TestListsEncoding(t *testing.T) {
+	factories := map[string]func() ADTList.List[string]{
+		"Linked":       ADTList.NewLinkedList[string],
+		"DoublyLinked": func() ADTList.List[string] { return ADTList.NewDoublyLinkedList[string]() },
+	}
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			list := create()
+			for _, element := range []string{"x", "y", "z"} {
+				list.InsertLast(element)
+			}
+
+			binary, err := list.(codec.Serializable).MarshalBinary()
+			require.NoError(t, err)
+			decoded := create()
+			decoded.InsertLast("old")
+			require.NoError(t, decoded.(codec.Serializable).UnmarshalBinary(binary))
+			require.Equal(t, []string{"x", "y", "z"}, listElements(decoded))
+			require.Equal(t, "z", decoded.SeeLast())
+
+			text, err := list.(codec.Serializable).MarshalJSON()
+			require.NoError(t, err)
+			require.JSONEq(t, `["x","y","z"]`, string(text))
+			decoded = create()
+			require.NoError(t, decoded.(codec.Serializable).UnmarshalJSON(text))
+			require.Equal(t, []string{"x", "y", "z"}, listElements(decoded))
+
+			require.Error(t, decoded.(codec.Serializable).UnmarshalJSON([]byte(`{"x":1}`)))
+			require.Equal(t, []string{"x", "y", "z"}, listElements(decoded), "A failed unmarshal should not modify the list")
+		})
+	}
+}
+
+func TestListIteratorFailsOnModification(t *testing.T) {
+	factories := map[string]func() ADTList.List[int]{
+		"Linked":       ADTList.NewLinkedList[int],
+		"ArenaLinked":  ADTList.NewArenaLinkedList[int],
+		"DoublyLinked": func() ADTList.List[int] { return ADTList.NewDoublyLinkedList[int]() },
+	}
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			list := create()
+			for i := 0; i < 5; i++ {
+				list.InsertLast(i)
+			}
+
+			iter := list.Iterator()
+			iter.Next()
+			iter.Insert(10)
+			iter.Next()
+			require.Equal(t, 1, iter.Delete())
+			require.Equal(t, 2, iter.SeeCurrent(), "The iterator's own Insert and Delete should keep it valid")
+			require.Equal(t, []int{0, 10, 2, 3, 4}, listElements(list))
+
+			list.DeleteFirst()
+			require.PanicsWithValue(t, "The list was modified while iterating", func() { iter.HasNext() })
+			require.PanicsWithValue(t, "The list was modified while iterating", func() { iter.SeeCurrent() })
+			require.PanicsWithValue(t, "The list was modified while iterating", func() { iter.Insert(0) })
+
+			iter = list.Iterator()
+			list.InsertFirst(-1)
+			require.PanicsWithValue(t, "The list was modified while iterating", func() { iter.Next() })
+		})
+	}
+}
+
+func TestDoublyLinkedListNodeOperationsInvalidateIterator(t *testing.T) {
+	list := ADTList.NewDoublyLinkedList[int]()
+	list.PushLast(1)
+	last := list.PushLast(2)
+
+	iter := list.Iterator()
+	last.Value = 20
+	require.True(t, iter.HasNext(), "Updating the value of a node should not invalidate the iterator")
+
+	list.MoveToFirst(last)
+	require.PanicsWithValue(t, "The list was modified while iterating", func() { iter.HasNext() })
+
+	iter = list.Iterator()
+	list.Remove(last)
+	require.PanicsWithValue(t, "The list was modified while iterating", func() { iter.HasNext() })
+}
+
+func TestListTryMethods(t *testing.T) {
+	factories := map[string]func() ADTList.List[int]{
+		"Linked":       ADTList.NewLinkedList[int],
+		"ArenaLinked":  ADTList.NewArenaLinkedList[int],
+		"DoublyLinked": func() ADTList.List[int] { return ADTList.NewDoublyLinkedList[int]() },
+	}
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			list := create()
+			_, err := ADTList.TryDeleteFirst(list)
+			require.ErrorIs(t, err, ADTList.ErrEmpty)
+			_, err = ADTList.TrySeeFirst(list)
+			require.ErrorIs(t, err, ADTList.ErrEmpty)
+			_, err = ADTList.TrySeeLast(list)
+			require.ErrorIs(t, err, ADTList.ErrEmpty)
+
+			list.InsertLast(1)
+			list.InsertLast(2)
+			first, err := ADTList.TrySeeFirst(list)
+			require.NoError(t, err)
+			require.Equal(t, 1, first)
+			last, err := ADTList.TrySeeLast(list)
+			require.NoError(t, err)
+			require.Equal(t, 2, last)
+			first, err = ADTList.TryDeleteFirst(list)
+			require.NoError(t, err)
+			require.Equal(t, 1, first)
+
+			iter := list.Iterator()
+			current, err := ADTList.TrySeeCurrent(iter)
+			require.NoError(t, err)
+			require.Equal(t, 2, current)
+			iter.Next()
+			_, err = ADTList.TrySeeCurrent(iter)
+			require.ErrorIs(t, err, ADTList.ErrIteratorExhausted)
+		})
+	}
+}
+
+func TestListsConformance(t *testing.T) {
+	t.Run("Linked", func(t *testing.T) {
+		listtest.RunConformance(t, ADTList.NewLinkedList[int])
+	})
+	t.Run("DoublyLinked", func(t *testing.T) {
+		listtest.RunConformance(t, func() ADTList.List[int] { return ADTList.NewDoublyLinkedList[int]() })
+	})
+	t.Run("Arena", func(t *testing.T) {
+		listtest.RunConformance(t, ADTList.NewArenaLinkedList[int])
+	})
+	t.Run("Synchronized", func(t *testing.T) {
+		listtest.RunConformance(t, func() ADTList.List[int] {
+			return ADTList.NewSynchronized(ADTList.NewLinkedList[int]())
+		})
+	})
+}
+
+func TestListsValidate(t *testing.T) {
+	factories := map[string]func() ADTList.List[int]{
+		"Linked":       ADTList.NewLinkedList[int],
+		"ArenaLinked":  ADTList.NewArenaLinkedList[int],
+		"DoublyLinked": func() ADTList.List[int] { return ADTList.NewDoublyLinkedList[int]() },
+	}
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			list := create()
+			require.Implements(t, (*debug.Validator)(nil), list)
+			require.NoError(t, debug.Validate(list))
+			for i := 0; i < 10; i++ {
+				list.InsertLast(i)
+			}
+			iter := list.Iterator()
+			for iter.HasNext() {
+				iter.Delete()
+				require.NoError(t, debug.Validate(list))
+			}
+			list.InsertFirst(1)
+			require.NoError(t, debug.Validate(list))
+		})
+	}
+}
+
+func TestSynchronizedListIteratesSnapshot(t *testing.T) {
+	list := ADTList.NewSynchronized(ADTList.NewLinkedList[int]())
+	for i := 1; i <= 3; i++ {
+		list.InsertLast(i)
+	}
+	visited := []int{}
+	list.Iterate(func(element int) bool {
+		visited = append(visited, element)
+		list.InsertLast(element * 10)
+		return true
+	})
+	require.Equal(t, []int{1, 2, 3}, visited, "Iterate should visit the elements there were when it was called")
+	require.Equal(t, []int{1, 2, 3, 10, 20, 30}, listElements[int](list))
+}
+
+func TestSynchronizedListConcurrentUse(t *testing.T) {
+	const goroutines, inserts = 8, 500
+	list := ADTList.NewSynchronized(ADTList.NewLinkedList[int]())
+	var group sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		group.Add(1)
+		go func(g int) {
+			defer group.Done()
+			for i := 0; i < inserts; i++ {
+				list.InsertLast(i)
+				list.InsertFirst(-i)
+				list.DeleteFirstIfNotEmpty()
+				list.Iterate(func(int) bool { return true })
+			}
+		}(g)
+	}
+	group.Wait()
+
+	require.NoError(t, debug.Validate(list))
+	require.Equal(t, goroutines*inserts, list.Length())
+	list.Update(func(inner ADTList.List[int]) {
+		for !inner.IsEmpty() {
+			inner.DeleteFirst()
+		}
+	})
+	_, ok := list.DeleteFirstIfNotEmpty()
+	require.False(t, ok)
+}
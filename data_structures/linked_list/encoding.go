@@ -0,0 +1,101 @@
+package linked_list
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+)
+
+// Lists implement codec.Serializable: they are encoded from the first to the last element, and rebuilt by
+// inserting them back at the end. The binary form uses the default codec of the elements, and MarshalWith and
+// UnmarshalWith encode any list with a given one.
+
+// MarshalWith encodes the elements of the list in binary, from the first to the last, with the given codec.
+func MarshalWith[T any](list List[T], elements codec.Codec[T]) ([]byte, error) {
+	return codec.Slice(elements).Append(nil, codec.Collect(list.Iterate))
+}
+
+// UnmarshalWith replaces the contents of the list with the elements encoded by MarshalWith with the same codec.
+// If the data is not valid, the list is left untouched.
+func UnmarshalWith[T any](list List[T], data []byte, elements codec.Codec[T]) error {
+	decoded := []T{}
+	if err := codec.UnmarshalSequence(data, elements, func(element T) { decoded = append(decoded, element) }); err != nil {
+		return err
+	}
+	replaceContents(list, decoded)
+	return nil
+}
+
+// replaceContents empties the list and inserts the elements at the end, all at once if the list is synchronized.
+func replaceContents[T any](list List[T], elements []T) {
+	switch list := list.(type) {
+	case *linkedList[T]:
+		list.replace(elements)
+	case SynchronizedList[T]:
+		list.Update(func(list List[T]) { replaceContents(list, elements) })
+	default:
+		for !list.IsEmpty() {
+			list.DeleteFirst()
+		}
+		for _, element := range elements {
+			list.InsertLast(element)
+		}
+	}
+}
+
+// Linked list
+
+func (list *linkedList[T]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[T](list, codec.Default[T]())
+}
+
+func (list *linkedList[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSequenceJSON(list.length, list.Iterate)
+}
+
+func (list *linkedList[T]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[T](list, data, codec.Default[T]())
+}
+
+func (list *linkedList[T]) UnmarshalJSON(data []byte) error {
+	elements := []T{}
+	add := func(element T) { elements = append(elements, element) }
+	if err := codec.UnmarshalSequenceJSON(data, add); err != nil {
+		return err
+	}
+	list.replace(elements)
+	return nil
+}
+
+func (list *linkedList[T]) replace(elements []T) {
+	decoded := newLinkedList[T](list.nodes != nil)
+	for _, element := range elements {
+		decoded.InsertLast(element)
+	}
+	decoded.modifications.Replaces(list.modifications)
+	*list = *decoded
+}
+
+// Doubly linked list
+
+func (list *doublyLinkedList[T]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[T](list, codec.Default[T]())
+}
+
+func (list *doublyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSequenceJSON(list.length, list.Iterate)
+}
+
+// The nodes of a doubly linked list point to the list they belong to, so unmarshalling reuses the list
+// instead of copying a new one over it. The nodes it held before no longer belong to it.
+func (list *doublyLinkedList[T]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[T](list, data, codec.Default[T]())
+}
+
+func (list *doublyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	elements := []T{}
+	add := func(element T) { elements = append(elements, element) }
+	if err := codec.UnmarshalSequenceJSON(data, add); err != nil {
+		return err
+	}
+	replaceContents[T](list, elements)
+	return nil
+}
@@ -1,8 +1,14 @@
 package linked_list
 
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/arena"
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+)
+
 const (
-	_EMPTY_LIST_MESSAGE = "The list is empty"
-	_END_OF_ITERATION   = "The iterator has finished iterating"
+	_EMPTY_LIST_MESSAGE      = "The list is empty"
+	_END_OF_ITERATION        = "The iterator has finished iterating"
+	_CONCURRENT_MODIFICATION = "The list was modified while iterating"
 )
 
 type listNode[T any] struct {
@@ -14,24 +20,54 @@ type linkedList[T any] struct {
 	first  *listNode[T]
 	last   *listNode[T]
 	length int
+	// nodes is the arena the nodes are allocated from, or nil if each one is allocated on its own
+	nodes         *arena.Arena[listNode[T]]
+	modifications modifications.Counter
 }
 
 type linkedListIterator[T any] struct {
-	current  *listNode[T]
-	previous *listNode[T]
-	list     *linkedList[T]
+	current       *listNode[T]
+	previous      *listNode[T]
+	list          *linkedList[T]
+	modifications modifications.Check
 }
 
-func createNode[T any](element T) *listNode[T] {
-	node := new(listNode[T])
+// createNode allocates a node for the element, from the arena of the list if it has one.
+func (list *linkedList[T]) createNode(element T) *listNode[T] {
+	var node *listNode[T]
+	if list.nodes != nil {
+		node = list.nodes.Alloc()
+	} else {
+		node = new(listNode[T])
+	}
 	node.data = element
 	return node
 }
 
+// freeNode gives an unlinked node back to the arena of the list, if it has one.
+func (list *linkedList[T]) freeNode(node *listNode[T]) {
+	if list.nodes != nil {
+		list.nodes.Free(node)
+	}
+}
+
 // List primitives
 
+func newLinkedList[T any](withArena bool) *linkedList[T] {
+	list := new(linkedList[T])
+	if withArena {
+		list.nodes = arena.New(func(node *listNode[T]) **listNode[T] { return &node.next })
+	}
+	return list
+}
+
 func NewLinkedList[T any]() List[T] {
-	return new(linkedList[T])
+	return newLinkedList[T](false)
+}
+
+// NewArenaLinkedList creates a linked list whose nodes are allocated in slabs and reused once deleted.
+func NewArenaLinkedList[T any]() List[T] {
+	return newLinkedList[T](true)
 }
 
 func (list *linkedList[T]) IsEmpty() bool {
@@ -39,17 +75,18 @@ func (list *linkedList[T]) IsEmpty() bool {
 }
 
 func (list *linkedList[T]) InsertFirst(data T) {
-	newNode := createNode(data)
+	newNode := list.createNode(data)
 	if list.IsEmpty() {
 		list.last = newNode
 	}
 	newNode.next = list.first
 	list.first = newNode
 	list.length++
+	list.modifications.Modified()
 }
 
 func (list *linkedList[T]) InsertLast(data T) {
-	newNode := createNode(data)
+	newNode := list.createNode(data)
 	if list.IsEmpty() {
 		list.first = newNode
 	} else {
@@ -57,18 +94,22 @@ func (list *linkedList[T]) InsertLast(data T) {
 	}
 	list.last = newNode
 	list.length++
+	list.modifications.Modified()
 }
 
 func (list *linkedList[T]) DeleteFirst() T {
 	if list.IsEmpty() {
 		panic(_EMPTY_LIST_MESSAGE)
 	}
-	element := list.first.data
-	list.first = list.first.next
+	first := list.first
+	list.first = first.next
 	if list.first == nil {
 		list.last = nil
 	}
+	element := first.data
+	list.freeNode(first)
 	list.length--
+	list.modifications.Modified()
 	return element
 }
 
@@ -108,10 +149,12 @@ func (list *linkedList[T]) Iterator() ListIterator[T] {
 	iterator := new(linkedListIterator[T])
 	iterator.current = list.first
 	iterator.list = list
+	iterator.modifications = modifications.NewCheck(&list.modifications, _CONCURRENT_MODIFICATION)
 	return iterator
 }
 
 func (iterator *linkedListIterator[T]) HasNext() bool {
+	iterator.modifications.Verify()
 	return iterator.current != nil
 }
 
@@ -131,7 +174,8 @@ func (iterator *linkedListIterator[T]) Next() {
 }
 
 func (iterator *linkedListIterator[T]) Insert(data T) {
-	newNode := createNode(data)
+	iterator.modifications.Verify()
+	newNode := iterator.list.createNode(data)
 
 	if iterator.current == nil {
 		iterator.list.last = newNode
@@ -146,6 +190,8 @@ func (iterator *linkedListIterator[T]) Insert(data T) {
 	newNode.next = iterator.current
 	iterator.current = newNode
 	iterator.list.length++
+	iterator.list.modifications.Modified()
+	iterator.modifications.Sync()
 }
 
 func (iterator *linkedListIterator[T]) Delete() T {
@@ -161,7 +207,11 @@ func (iterator *linkedListIterator[T]) Delete() T {
 		iterator.list.last = iterator.previous
 	}
 
-	iterator.current = iterator.current.next
+	deleted := iterator.current
+	iterator.current = deleted.next
+	iterator.list.freeNode(deleted)
 	iterator.list.length--
+	iterator.list.modifications.Modified()
+	iterator.modifications.Sync()
 	return data
 }
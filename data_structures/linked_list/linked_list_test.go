@@ -683,3 +683,29 @@ func TestVolumenIterador(t *testing.T) {
 	require.Equal(t, 0, lista.Length(), "El Length de la lista debe ser 0")
 	require.True(t, lista.IsEmpty(), "La lista debe estar vacia")
 }
+
+// BenchmarkNodeAllocation compares allocating each node on its own against allocating them from an arena,
+// while filling a list, cycling its elements through it and emptying it.
+func BenchmarkNodeAllocation(b *testing.B) {
+	factories := map[string]func() ADTList.List[int]{
+		"Heap":  ADTList.NewLinkedList[int],
+		"Arena": ADTList.NewArenaLinkedList[int],
+	}
+	for name, create := range factories {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				list := create()
+				for j := 0; j < _INT_VOL; j++ {
+					list.InsertLast(j)
+				}
+				for j := 0; j < _INT_VOL; j++ {
+					list.InsertLast(list.DeleteFirst())
+				}
+				for !list.IsEmpty() {
+					list.DeleteFirst()
+				}
+			}
+		})
+	}
+}
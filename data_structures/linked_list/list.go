@@ -1,5 +1,15 @@
 package linked_list
 
+import "errors"
+
+var (
+	// ErrEmpty is returned by TryDeleteFirst, TrySeeFirst and TrySeeLast when the list is empty.
+	ErrEmpty = errors.New("linked_list: empty list")
+
+	// ErrIteratorExhausted is returned by TrySeeCurrent when the iterator has finished traversing the list.
+	ErrIteratorExhausted = errors.New("linked_list: iterator exhausted")
+)
+
 type List[T any] interface {
 	// IsEmpty returns true if the list has no elements, false otherwise.
 	IsEmpty() bool
@@ -34,6 +44,9 @@ type List[T any] interface {
 	Iterator() ListIterator[T]
 }
 
+// ListIterator is invalidated when its list is modified other than through the iterator's own Insert and Delete,
+// which are the safe way to change the list while iterating. From then on, any of its methods panics with the
+// message "The list was modified while iterating". Updating the value of a node is always allowed.
 type ListIterator[T any] interface {
 	// SeeCurrent returns the value of the current element of the iterator.
 	// If the iterator has finished traversing the list, it panics with the message "The iterator has finished iterating".
@@ -56,3 +69,81 @@ type ListIterator[T any] interface {
 	// If the iterator has finished traversing the list, it panics with the message "The iterator has finished iterating".
 	Delete() T
 }
+
+// TryDeleteFirst removes the first element from the list and returns its value, or ErrEmpty if it is empty.
+// Like TrySeeFirst and TrySeeLast, it calls the method of the same name if the list has one, which lets the
+// synchronized lists check and delete under a single lock.
+func TryDeleteFirst[T any](list List[T]) (T, error) {
+	if list, ok := list.(interface{ TryDeleteFirst() (T, error) }); ok {
+		return list.TryDeleteFirst()
+	}
+	if list.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.DeleteFirst(), nil
+}
+
+// TrySeeFirst gets the value of the first element in the list, or ErrEmpty if it is empty.
+func TrySeeFirst[T any](list List[T]) (T, error) {
+	if list, ok := list.(interface{ TrySeeFirst() (T, error) }); ok {
+		return list.TrySeeFirst()
+	}
+	if list.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.SeeFirst(), nil
+}
+
+// TrySeeLast gets the value of the last element in the list, or ErrEmpty if it is empty.
+func TrySeeLast[T any](list List[T]) (T, error) {
+	if list, ok := list.(interface{ TrySeeLast() (T, error) }); ok {
+		return list.TrySeeLast()
+	}
+	if list.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.SeeLast(), nil
+}
+
+// TrySeeCurrent returns the value of the current element of the iterator, or ErrIteratorExhausted if it has
+// finished traversing the list.
+func TrySeeCurrent[T any](iterator ListIterator[T]) (T, error) {
+	if !iterator.HasNext() {
+		var zero T
+		return zero, ErrIteratorExhausted
+	}
+	return iterator.SeeCurrent(), nil
+}
+
+// DoublyLinkedList is a List whose nodes are linked in both directions. Inserting an element returns a handle
+// to its node, which allows moving or removing it later in constant time.
+type DoublyLinkedList[T any] interface {
+	List[T]
+
+	// PushFirst inserts a new element at the beginning of the list and returns its node.
+	PushFirst(T) *Node[T]
+
+	// PushLast inserts a new element at the end of the list and returns its node.
+	PushLast(T) *Node[T]
+
+	// InsertAfter inserts a new element right after the given node and returns its node.
+	// If the node does not belong to the list, it panics with the message "The node does not belong to the list".
+	InsertAfter(node *Node[T], data T) *Node[T]
+
+	// FirstNode returns the first node of the list, or nil if the list is empty.
+	FirstNode() *Node[T]
+
+	// LastNode returns the last node of the list, or nil if the list is empty.
+	LastNode() *Node[T]
+
+	// MoveToFirst moves the given node to the beginning of the list.
+	// If the node does not belong to the list, it panics with the message "The node does not belong to the list".
+	MoveToFirst(node *Node[T])
+
+	// Remove removes the given node from the list and returns its value.
+	// If the node does not belong to the list, it panics with the message "The node does not belong to the list".
+	Remove(node *Node[T]) T
+}
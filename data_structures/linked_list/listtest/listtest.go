@@ -0,0 +1,277 @@
+// Package listtest verifies that an implementation of linked_list.List behaves as the package documents, so
+// that a new implementation can be checked with a single call to RunConformance from its tests.
+package listtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+	ADTList "github.com/sebagarciad/algorithms-and-data-structures/linked_list"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_EMPTY_LIST              = "The list is empty"
+	_END_OF_ITERATION        = "The iterator has finished iterating"
+	_CONCURRENT_MODIFICATION = "The list was modified while iterating"
+	_VOLUME                  = 10000
+	_RANDOM_OPS              = 5000
+)
+
+// RunConformance runs the behavioural, volume, encoding and randomized tests against the lists created by
+// factory, each of which must be empty.
+// The encoding tests are skipped for the lists that do not implement codec.Serializable.
+func RunConformance(t *testing.T, factory func() ADTList.List[int]) {
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, factory) })
+	t.Run("InsertAndDelete", func(t *testing.T) { testInsertAndDelete(t, factory) })
+	t.Run("InternalIterator", func(t *testing.T) { testInternalIterator(t, factory) })
+	t.Run("IteratorInsert", func(t *testing.T) { testIteratorInsert(t, factory) })
+	t.Run("IteratorDelete", func(t *testing.T) { testIteratorDelete(t, factory) })
+	t.Run("IteratorFailsFast", func(t *testing.T) { testIteratorFailsFast(t, factory) })
+	t.Run("Volume", func(t *testing.T) { testVolume(t, factory) })
+	t.Run("Encoding", func(t *testing.T) { testEncoding(t, factory) })
+	t.Run("Randomized", func(t *testing.T) { testRandomized(t, factory) })
+}
+
+// elements returns the elements of the list in order, checking that its ends and length agree with them.
+func elements(t *testing.T, list ADTList.List[int]) []int {
+	result := []int{}
+	list.Iterate(func(element int) bool {
+		result = append(result, element)
+		return true
+	})
+	require.EqualValues(t, len(result), list.Length())
+	require.Equal(t, len(result) == 0, list.IsEmpty())
+	if len(result) > 0 {
+		require.Equal(t, result[0], list.SeeFirst())
+		require.Equal(t, result[len(result)-1], list.SeeLast())
+	}
+	return result
+}
+
+func fromSlice(factory func() ADTList.List[int], elements []int) ADTList.List[int] {
+	list := factory()
+	for _, element := range elements {
+		list.InsertLast(element)
+	}
+	return list
+}
+
+func testEmpty(t *testing.T, factory func() ADTList.List[int]) {
+	list := factory()
+	require.True(t, list.IsEmpty())
+	require.EqualValues(t, 0, list.Length())
+	require.PanicsWithValue(t, _EMPTY_LIST, func() { list.SeeFirst() })
+	require.PanicsWithValue(t, _EMPTY_LIST, func() { list.SeeLast() })
+	require.PanicsWithValue(t, _EMPTY_LIST, func() { list.DeleteFirst() })
+	_, err := ADTList.TryDeleteFirst(list)
+	require.ErrorIs(t, err, ADTList.ErrEmpty)
+	_, err = ADTList.TrySeeFirst(list)
+	require.ErrorIs(t, err, ADTList.ErrEmpty)
+	_, err = ADTList.TrySeeLast(list)
+	require.ErrorIs(t, err, ADTList.ErrEmpty)
+
+	iter := list.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, _END_OF_ITERATION, func() { iter.SeeCurrent() })
+	require.PanicsWithValue(t, _END_OF_ITERATION, func() { iter.Next() })
+	require.PanicsWithValue(t, _END_OF_ITERATION, func() { iter.Delete() })
+}
+
+func testInsertAndDelete(t *testing.T, factory func() ADTList.List[int]) {
+	list := factory()
+	list.InsertLast(2)
+	list.InsertFirst(1)
+	list.InsertLast(3)
+	require.Equal(t, []int{1, 2, 3}, elements(t, list))
+
+	require.Equal(t, 1, list.DeleteFirst())
+	first, err := ADTList.TryDeleteFirst(list)
+	require.NoError(t, err)
+	require.Equal(t, 2, first)
+	require.Equal(t, 3, list.DeleteFirst())
+	require.Empty(t, elements(t, list))
+
+	list.InsertFirst(4)
+	require.Equal(t, []int{4}, elements(t, list), "A list emptied by deleting should behave as a new one")
+}
+
+func testInternalIterator(t *testing.T, factory func() ADTList.List[int]) {
+	list := fromSlice(factory, []int{1, 2, 3, 4, 5})
+	visited := []int{}
+	list.Iterate(func(element int) bool {
+		visited = append(visited, element)
+		return element < 3
+	})
+	require.Equal(t, []int{1, 2, 3}, visited, "The iteration should stop when visit returns false")
+
+	factory().Iterate(func(int) bool {
+		require.Fail(t, "An empty list has nothing to visit")
+		return true
+	})
+}
+
+func testIteratorInsert(t *testing.T, factory func() ADTList.List[int]) {
+	list := factory()
+	iter := list.Iterator()
+	iter.Insert(2)
+	require.Equal(t, 2, iter.SeeCurrent())
+	require.Equal(t, []int{2}, elements(t, list))
+
+	iter.Insert(1)
+	require.Equal(t, []int{1, 2}, elements(t, list), "Inserting at the start should change the first element")
+
+	iter.Next()
+	iter.Next()
+	require.False(t, iter.HasNext())
+	iter.Insert(4)
+	require.Equal(t, []int{1, 2, 4}, elements(t, list), "Inserting at the end should change the last element")
+
+	iter = list.Iterator()
+	iter.Next()
+	iter.Next()
+	iter.Insert(3)
+	require.Equal(t, 3, iter.SeeCurrent())
+	iter.Next()
+	require.Equal(t, 4, iter.SeeCurrent())
+	require.Equal(t, []int{1, 2, 3, 4}, elements(t, list))
+
+	list.InsertLast(5)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, elements(t, list))
+}
+
+func testIteratorDelete(t *testing.T, factory func() ADTList.List[int]) {
+	list := fromSlice(factory, []int{1, 2, 3, 4, 5})
+	iter := list.Iterator()
+	require.Equal(t, 1, iter.Delete())
+	require.Equal(t, 2, iter.SeeCurrent())
+	require.Equal(t, []int{2, 3, 4, 5}, elements(t, list))
+
+	iter.Next()
+	require.Equal(t, 3, iter.Delete())
+	require.Equal(t, []int{2, 4, 5}, elements(t, list))
+
+	iter.Next()
+	require.Equal(t, 5, iter.Delete())
+	require.False(t, iter.HasNext())
+	require.Equal(t, []int{2, 4}, elements(t, list), "Deleting the last element should change the last element")
+
+	list.InsertLast(6)
+	require.Equal(t, []int{2, 4, 6}, elements(t, list))
+
+	iter = list.Iterator()
+	for iter.HasNext() {
+		iter.Delete()
+	}
+	require.Empty(t, elements(t, list))
+	list.InsertLast(7)
+	require.Equal(t, []int{7}, elements(t, list))
+}
+
+func testIteratorFailsFast(t *testing.T, factory func() ADTList.List[int]) {
+	list := fromSlice(factory, []int{1, 2, 3})
+	iter := list.Iterator()
+	list.InsertLast(4)
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.HasNext() })
+
+	iter = list.Iterator()
+	list.DeleteFirst()
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.SeeCurrent() })
+
+	iter = list.Iterator()
+	other := list.Iterator()
+	iter.Delete()
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { other.Next() },
+		"Deleting through an iterator should invalidate the other ones")
+	require.True(t, iter.HasNext())
+}
+
+func testVolume(t *testing.T, factory func() ADTList.List[int]) {
+	list := factory()
+	for i := 0; i < _VOLUME; i++ {
+		list.InsertLast(i)
+	}
+	require.EqualValues(t, _VOLUME, list.Length())
+
+	iter := list.Iterator()
+	for i := 0; iter.HasNext(); i++ {
+		require.Equal(t, i, iter.SeeCurrent())
+		if i%2 == 0 {
+			iter.Delete()
+		} else {
+			iter.Next()
+		}
+	}
+	for i := 1; i < _VOLUME; i += 2 {
+		require.Equal(t, i, list.DeleteFirst())
+	}
+	require.True(t, list.IsEmpty())
+}
+
+func testEncoding(t *testing.T, factory func() ADTList.List[int]) {
+	list := fromSlice(factory, []int{3, 1, 4, 1, 5})
+	if _, ok := list.(codec.Serializable); !ok {
+		t.Skip("The list does not implement codec.Serializable")
+	}
+
+	binary, err := list.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+	fromBinary := fromSlice(factory, []int{9})
+	require.NoError(t, fromBinary.(codec.Serializable).UnmarshalBinary(binary))
+	require.Equal(t, []int{3, 1, 4, 1, 5}, elements(t, fromBinary), "Unmarshalling should replace the contents of the list")
+
+	text, err := list.(codec.Serializable).MarshalJSON()
+	require.NoError(t, err)
+	fromJSON := factory()
+	require.NoError(t, fromJSON.(codec.Serializable).UnmarshalJSON(text))
+	require.Equal(t, []int{3, 1, 4, 1, 5}, elements(t, fromJSON))
+
+	require.Error(t, fromJSON.(codec.Serializable).UnmarshalBinary(binary[:len(binary)-1]))
+	require.Equal(t, []int{3, 1, 4, 1, 5}, elements(t, fromJSON), "A failed unmarshal should not modify the list")
+}
+
+// testRandomized applies random operations, through the list and through an iterator, to the list and to a
+// slice, and checks that both always agree and, if the list is a debug.Validator, that its invariants hold.
+func testRandomized(t *testing.T, factory func() ADTList.List[int]) {
+	rng := rand.New(rand.NewSource(1))
+	list := factory()
+	model := []int{}
+	for i := 0; i < _RANDOM_OPS; i++ {
+		switch rng.Intn(5) {
+		case 0:
+			list.InsertFirst(i)
+			model = append([]int{i}, model...)
+		case 1:
+			list.InsertLast(i)
+			model = append(model, i)
+		case 2:
+			first, err := ADTList.TryDeleteFirst(list)
+			if len(model) == 0 {
+				require.ErrorIs(t, err, ADTList.ErrEmpty)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, model[0], first)
+			model = model[1:]
+		default:
+			// Walk to a random position and insert or delete there
+			position := rng.Intn(len(model) + 1)
+			iter := list.Iterator()
+			for j := 0; j < position; j++ {
+				iter.Next()
+			}
+			if position == len(model) || rng.Intn(2) == 0 {
+				iter.Insert(i)
+				model = append(model[:position], append([]int{i}, model[position:]...)...)
+			} else {
+				require.Equal(t, model[position], iter.Delete())
+				model = append(model[:position], model[position+1:]...)
+			}
+		}
+		require.NoError(t, debug.Validate(list))
+		require.Equal(t, model, elements(t, list))
+	}
+}
@@ -0,0 +1,226 @@
+package linked_list
+
+import (
+	"sync"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+)
+
+// SynchronizedList is a List that can be shared between goroutines. Each of its operations is atomic, and so are
+// the compound operations it adds, which would otherwise need a lock held across several calls.
+//
+// Iterate visits a snapshot of the elements taken when it is called, so visit may use the list and other
+// goroutines are not blocked while it runs. An iterator, on the other hand, walks the list itself: it fails fast
+// like the iterators of the wrapped list, and it must not be shared between goroutines.
+type SynchronizedList[T any] interface {
+	List[T]
+
+	// DeleteFirstIfNotEmpty removes the first element from the list and returns its value and true, or false if
+	// the list is empty.
+	DeleteFirstIfNotEmpty() (T, bool)
+
+	// Update runs update with exclusive access to the wrapped list, so that the operations it performs on it are
+	// atomic. The list it receives must not be kept after update returns.
+	Update(update func(List[T]))
+}
+
+type synchronizedList[T any] struct {
+	lock sync.RWMutex
+	list List[T]
+}
+
+type synchronizedListIterator[T any] struct {
+	list  *synchronizedList[T]
+	inner ListIterator[T]
+}
+
+// NewSynchronized wraps the list in a SynchronizedList, which guards it with a read-write lock. The list must not
+// be used directly afterwards.
+func NewSynchronized[T any](list List[T]) SynchronizedList[T] {
+	return &synchronizedList[T]{list: list}
+}
+
+func (synchronized *synchronizedList[T]) IsEmpty() bool {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return synchronized.list.IsEmpty()
+}
+
+func (synchronized *synchronizedList[T]) InsertFirst(element T) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	synchronized.list.InsertFirst(element)
+}
+
+func (synchronized *synchronizedList[T]) InsertLast(element T) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	synchronized.list.InsertLast(element)
+}
+
+func (synchronized *synchronizedList[T]) DeleteFirst() T {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	return synchronized.list.DeleteFirst()
+}
+
+func (synchronized *synchronizedList[T]) SeeFirst() T {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return synchronized.list.SeeFirst()
+}
+
+func (synchronized *synchronizedList[T]) SeeLast() T {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return synchronized.list.SeeLast()
+}
+
+func (synchronized *synchronizedList[T]) TryDeleteFirst() (T, error) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	return TryDeleteFirst(synchronized.list)
+}
+
+func (synchronized *synchronizedList[T]) TrySeeFirst() (T, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return TrySeeFirst(synchronized.list)
+}
+
+func (synchronized *synchronizedList[T]) TrySeeLast() (T, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return TrySeeLast(synchronized.list)
+}
+
+func (synchronized *synchronizedList[T]) Length() int {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return synchronized.list.Length()
+}
+
+func (synchronized *synchronizedList[T]) DeleteFirstIfNotEmpty() (T, bool) {
+	first, err := synchronized.TryDeleteFirst()
+	return first, err == nil
+}
+
+func (synchronized *synchronizedList[T]) Update(update func(List[T])) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	update(synchronized.list)
+}
+
+// Validate checks the invariants of the wrapped list, if it has any.
+func (synchronized *synchronizedList[T]) Validate() error {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return debug.Validate(synchronized.list)
+}
+
+// snapshot copies the elements of the list in order.
+func (synchronized *synchronizedList[T]) snapshot() []T {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	elements := make([]T, 0, synchronized.list.Length())
+	synchronized.list.Iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	return elements
+}
+
+func (synchronized *synchronizedList[T]) Iterate(visit func(T) bool) {
+	for _, element := range synchronized.snapshot() {
+		if !visit(element) {
+			return
+		}
+	}
+}
+
+func (synchronized *synchronizedList[T]) Iterator() ListIterator[T] {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return &synchronizedListIterator[T]{synchronized, synchronized.list.Iterator()}
+}
+
+func (iterator *synchronizedListIterator[T]) SeeCurrent() T {
+	iterator.list.lock.RLock()
+	defer iterator.list.lock.RUnlock()
+	return iterator.inner.SeeCurrent()
+}
+
+func (iterator *synchronizedListIterator[T]) HasNext() bool {
+	iterator.list.lock.RLock()
+	defer iterator.list.lock.RUnlock()
+	return iterator.inner.HasNext()
+}
+
+// Next only moves the iterator, which is not shared, so it does not need the exclusive lock.
+func (iterator *synchronizedListIterator[T]) Next() {
+	iterator.list.lock.RLock()
+	defer iterator.list.lock.RUnlock()
+	iterator.inner.Next()
+}
+
+func (iterator *synchronizedListIterator[T]) Insert(element T) {
+	iterator.list.lock.Lock()
+	defer iterator.list.lock.Unlock()
+	iterator.inner.Insert(element)
+}
+
+func (iterator *synchronizedListIterator[T]) Delete() T {
+	iterator.list.lock.Lock()
+	defer iterator.list.lock.Unlock()
+	return iterator.inner.Delete()
+}
+
+// serializable returns the wrapped list, or codec.ErrNotSerializable if it does not implement
+// codec.Serializable.
+func (synchronized *synchronizedList[T]) serializable() (codec.Serializable, error) {
+	if serializable, ok := synchronized.list.(codec.Serializable); ok {
+		return serializable, nil
+	}
+	return nil, codec.ErrNotSerializable
+}
+
+func (synchronized *synchronizedList[T]) MarshalBinary() ([]byte, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return nil, err
+	}
+	return serializable.MarshalBinary()
+}
+
+func (synchronized *synchronizedList[T]) UnmarshalBinary(data []byte) error {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return err
+	}
+	return serializable.UnmarshalBinary(data)
+}
+
+func (synchronized *synchronizedList[T]) MarshalJSON() ([]byte, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return nil, err
+	}
+	return serializable.MarshalJSON()
+}
+
+func (synchronized *synchronizedList[T]) UnmarshalJSON(data []byte) error {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return err
+	}
+	return serializable.UnmarshalJSON(data)
+}
@@ -0,0 +1,50 @@
+package linked_list
+
+import "github.com/sebagarciad/algorithms-and-data-structures/debug"
+
+// Validate checks that the list has as many nodes as its length and that last points to the final one.
+func (list *linkedList[T]) Validate() error {
+	nodes := 0
+	var previous *listNode[T]
+	for current := list.first; current != nil; current = current.next {
+		if nodes == list.length {
+			return debug.Violation("the list has more nodes than its length %d", list.length)
+		}
+		previous = current
+		nodes++
+	}
+	if nodes != list.length {
+		return debug.Violation("the list has %d nodes, but its length is %d", nodes, list.length)
+	}
+	if list.last != previous {
+		return debug.Violation("last does not point to the final node")
+	}
+	return nil
+}
+
+// Validate checks that the list has as many nodes as its length, that every node links back to the one before
+// it and belongs to the list, and that last points to the final one.
+func (list *doublyLinkedList[T]) Validate() error {
+	nodes := 0
+	var previous *Node[T]
+	for current := list.first; current != nil; current = current.next {
+		if nodes == list.length {
+			return debug.Violation("the list has more nodes than its length %d", list.length)
+		}
+		if current.previous != previous {
+			return debug.Violation("the node at %d does not link back to the node before it", nodes)
+		}
+		if current.list != list {
+			return debug.Violation("the node at %d does not belong to the list", nodes)
+		}
+		previous = current
+		nodes++
+	}
+	if nodes != list.length {
+		return debug.Violation("the list has %d nodes, but its length is %d", nodes, list.length)
+	}
+	if list.last != previous {
+		return debug.Violation("last does not point to the final node")
+	}
+	return nil
+}
@@ -0,0 +1,405 @@
+package mymap
+
+import (
+	"cmp"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/avl"
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+)
+
+// ===================== Monoids ==========================
+
+// Monoid describes how to summarize the elements of an AggregateMap: Measure maps each element to an
+// aggregate, and Combine joins the aggregates of two consecutive groups of elements. Combine must be
+// associative and Identity must be its neutral element.
+type Monoid[K comparable, V any, A any] struct {
+	Identity A
+	Measure  func(key K, value V) A
+	Combine  func(A, A) A
+}
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// CountMonoid aggregates the number of elements.
+func CountMonoid[K comparable, V any]() Monoid[K, V, int] {
+	return Monoid[K, V, int]{
+		Identity: 0,
+		Measure:  func(K, V) int { return 1 },
+		Combine:  func(a, b int) int { return a + b },
+	}
+}
+
+// SumMonoid aggregates the sum of the values.
+func SumMonoid[K comparable, V Number]() Monoid[K, V, V] {
+	return Monoid[K, V, V]{
+		Identity: 0,
+		Measure:  func(_ K, value V) V { return value },
+		Combine:  func(a, b V) V { return a + b },
+	}
+}
+
+// MinMonoid aggregates the lowest value. The aggregate of no elements is nil.
+func MinMonoid[K comparable, V cmp.Ordered]() Monoid[K, V, *V] {
+	return Monoid[K, V, *V]{
+		Identity: nil,
+		Measure:  func(_ K, value V) *V { return &value },
+		Combine: func(a, b *V) *V {
+			if a == nil || (b != nil && *b < *a) {
+				return b
+			}
+			return a
+		},
+	}
+}
+
+// MaxMonoid aggregates the greatest value. The aggregate of no elements is nil.
+func MaxMonoid[K comparable, V cmp.Ordered]() Monoid[K, V, *V] {
+	return Monoid[K, V, *V]{
+		Identity: nil,
+		Measure:  func(_ K, value V) *V { return &value },
+		Combine: func(a, b *V) *V {
+			if a == nil || (b != nil && *b > *a) {
+				return b
+			}
+			return a
+		},
+	}
+}
+
+// ===================== Types ==========================
+
+// aggregateNode is a node of an AVL tree. aggregate summarizes every element of the subtree rooted at the node.
+type aggregateNode[K comparable, V any, A any] struct {
+	left      *aggregateNode[K, V, A]
+	right     *aggregateNode[K, V, A]
+	key       K
+	value     V
+	aggregate A
+	height    int
+}
+
+type aggregateTree[K comparable, V any, A any] struct {
+	root   *aggregateNode[K, V, A]
+	size   int
+	cmp    cmpFunc[K]
+	monoid Monoid[K, V, A]
+	// balancer rebalances the nodes of the tree, keeping their aggregates up to date
+	balancer      avl.Balancer[aggregateNode[K, V, A]]
+	modifications modifications.Counter
+}
+
+type aggregateIter[K comparable, V any, A any] struct {
+	stack         ADTStack.Stack[*aggregateNode[K, V, A]]
+	from          *K
+	to            *K
+	tree          *aggregateTree[K, V, A]
+	modifications modifications.Check
+}
+
+// ===================== AVL Helpers ==========================
+
+func (monoid Monoid[K, V, A]) aggregateOf(node *aggregateNode[K, V, A]) A {
+	if node == nil {
+		return monoid.Identity
+	}
+	return node.aggregate
+}
+
+func (monoid Monoid[K, V, A]) measure(node *aggregateNode[K, V, A]) A {
+	return monoid.Measure(node.key, node.value)
+}
+
+// augment recomputes the aggregate of the node from its children.
+func (monoid Monoid[K, V, A]) augment(node *aggregateNode[K, V, A]) {
+	combine := monoid.Combine
+	node.aggregate = combine(combine(monoid.aggregateOf(node.left), monoid.measure(node)), monoid.aggregateOf(node.right))
+}
+
+func (tree *aggregateTree[K, V, A]) findNode(key K) *aggregateNode[K, V, A] {
+	node := tree.root
+	for node != nil {
+		compare := tree.cmp(node.key, key)
+		if compare == 0 {
+			return node
+		}
+		if compare > 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return nil
+}
+
+func (tree *aggregateTree[K, V, A]) saveRec(node *aggregateNode[K, V, A], key K, value V) *aggregateNode[K, V, A] {
+	if node == nil {
+		tree.size++
+		tree.modifications.Modified()
+		newNode := new(aggregateNode[K, V, A])
+		newNode.key = key
+		newNode.value = value
+		tree.balancer.Update(newNode)
+		return newNode
+	}
+	compare := tree.cmp(node.key, key)
+	if compare > 0 {
+		node.left = tree.saveRec(node.left, key, value)
+	} else if compare < 0 {
+		node.right = tree.saveRec(node.right, key, value)
+	} else {
+		node.value = value
+	}
+	return tree.balancer.Rebalance(node)
+}
+
+// removeRec returns the subtree without the key, and whether removing it rotated any node.
+func (tree *aggregateTree[K, V, A]) removeRec(node *aggregateNode[K, V, A], key K) (*aggregateNode[K, V, A], bool) {
+	rotated := false
+	compare := tree.cmp(node.key, key)
+	if compare > 0 {
+		node.left, rotated = tree.removeRec(node.left, key)
+	} else if compare < 0 {
+		node.right, rotated = tree.removeRec(node.right, key)
+	} else {
+		if node.left == nil {
+			return node.right, false
+		}
+		if node.right == nil {
+			return node.left, false
+		}
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		node.key, node.value = successor.key, successor.value
+		node.right, rotated = tree.removeRec(node.right, successor.key)
+	}
+	balanced := tree.balancer.Rebalance(node)
+	return balanced, rotated || balanced != node
+}
+
+// suffix aggregates the keys of the subtree that are greater than or equal to from, walking a single path.
+func (tree *aggregateTree[K, V, A]) suffix(node *aggregateNode[K, V, A], from *K) A {
+	if from == nil {
+		return tree.monoid.aggregateOf(node)
+	}
+	combine := tree.monoid.Combine
+	result := tree.monoid.Identity
+	for node != nil {
+		if tree.cmp(node.key, *from) >= 0 {
+			result = combine(combine(tree.monoid.measure(node), tree.monoid.aggregateOf(node.right)), result)
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return result
+}
+
+// prefix aggregates the keys of the subtree that are lower than or equal to to, walking a single path.
+func (tree *aggregateTree[K, V, A]) prefix(node *aggregateNode[K, V, A], to *K) A {
+	if to == nil {
+		return tree.monoid.aggregateOf(node)
+	}
+	combine := tree.monoid.Combine
+	result := tree.monoid.Identity
+	for node != nil {
+		if tree.cmp(node.key, *to) <= 0 {
+			result = combine(result, combine(tree.monoid.aggregateOf(node.left), tree.monoid.measure(node)))
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return result
+}
+
+// ===================== CreateAggregateMap ==========================
+
+// CreateAggregateMap creates an ordered map backed by an AVL tree whose nodes keep the aggregate of their
+// subtrees according to the given monoid, so that the elements of any range can be summarized in O(log n).
+func CreateAggregateMap[K comparable, V any, A any](cmpFunc func(K, K) int, monoid Monoid[K, V, A]) AggregateMap[K, V, A] {
+	tree := new(aggregateTree[K, V, A])
+	tree.cmp = cmpFunc
+	tree.monoid = monoid
+	// The balancer is bound to the monoid rather than to the tree, since load replaces the tree with a copy
+	tree.balancer = avl.Balancer[aggregateNode[K, V, A]]{
+		Children: func(node *aggregateNode[K, V, A]) (**aggregateNode[K, V, A], **aggregateNode[K, V, A]) {
+			return &node.left, &node.right
+		},
+		Height:  func(node *aggregateNode[K, V, A]) *int { return &node.height },
+		Augment: monoid.augment,
+	}
+	return tree
+}
+
+// ===================== Primitives ==========================
+
+func (tree *aggregateTree[K, V, A]) Save(key K, value V) {
+	tree.root = tree.saveRec(tree.root, key, value)
+}
+
+func (tree *aggregateTree[K, V, A]) Contains(key K) bool {
+	return tree.findNode(key) != nil
+}
+
+func (tree *aggregateTree[K, V, A]) Get(key K) V {
+	node := tree.findNode(key)
+	if node == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	return node.value
+}
+
+func (tree *aggregateTree[K, V, A]) TryGet(key K) (V, error) {
+	node := tree.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return node.value, nil
+}
+
+func (tree *aggregateTree[K, V, A]) Remove(key K) V {
+	removed, _ := tree.remove(key)
+	return removed
+}
+
+// remove removes the key and returns its value, and whether removing it rotated any node.
+func (tree *aggregateTree[K, V, A]) remove(key K) (V, bool) {
+	node := tree.findNode(key)
+	if node == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	removed := node.value
+	root, rotated := tree.removeRec(tree.root, key)
+	tree.root = root
+	tree.size--
+	tree.modifications.Modified()
+	return removed, rotated
+}
+
+func (tree *aggregateTree[K, V, A]) Count() int {
+	return tree.size
+}
+
+func (tree *aggregateTree[K, V, A]) comparator() cmpFunc[K] {
+	return tree.cmp
+}
+
+// ===================== AggregateRange() ==========================
+
+// AggregateRange descends to the first node within the range, and then aggregates the part of its left
+// subtree above from and the part of its right subtree below to.
+func (tree *aggregateTree[K, V, A]) AggregateRange(from *K, to *K) A {
+	node := tree.root
+	for node != nil {
+		if from != nil && tree.cmp(node.key, *from) < 0 {
+			node = node.right
+		} else if to != nil && tree.cmp(node.key, *to) > 0 {
+			node = node.left
+		} else {
+			break
+		}
+	}
+	if node == nil {
+		return tree.monoid.Identity
+	}
+	combine := tree.monoid.Combine
+	return combine(combine(tree.suffix(node.left, from), tree.monoid.measure(node)), tree.prefix(node.right, to))
+}
+
+// =================== Internal Iterator ===================
+
+func (tree *aggregateTree[K, V, A]) Iterate(visit func(key K, value V) bool) {
+	tree.IterateRange(nil, nil, visit)
+}
+
+func (tree *aggregateTree[K, V, A]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	for iter := tree.IteratorRange(from, to); iter.HasNext(); iter.Next() {
+		if !visit(iter.Current()) {
+			return
+		}
+	}
+}
+
+// =================== External Iterator ===================
+
+func (tree *aggregateTree[K, V, A]) Iterator() MapIterator[K, V] {
+	return tree.IteratorRange(nil, nil)
+}
+
+func (tree *aggregateTree[K, V, A]) IteratorRange(from *K, to *K) MapIterator[K, V] {
+	iterator := new(aggregateIter[K, V, A])
+	iterator.stack = ADTStack.NewStack[*aggregateNode[K, V, A]]()
+	iterator.tree = tree
+	iterator.modifications = modifications.NewCheck(&tree.modifications, _CONCURRENT_MODIFICATION)
+	iterator.from = from
+	iterator.to = to
+	iterator.pushLeftUntil(tree.root, from)
+	return iterator
+}
+
+func (iterator *aggregateIter[K, V, A]) pushLeftUntil(node *aggregateNode[K, V, A], from *K) {
+	for node != nil {
+		if from == nil || iterator.tree.cmp(node.key, *from) >= 0 {
+			iterator.stack.Push(node)
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+}
+
+func (iterator *aggregateIter[K, V, A]) HasNext() bool {
+	iterator.modifications.Verify()
+	return !iterator.stack.IsEmpty() &&
+		(iterator.to == nil || iterator.tree.cmp(iterator.stack.Peek().key, *iterator.to) <= 0)
+}
+
+func (iterator *aggregateIter[K, V, A]) Current() (K, V) {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	current := iterator.stack.Peek()
+	return current.key, current.value
+}
+
+func (iterator *aggregateIter[K, V, A]) Next() {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	current := iterator.stack.Pop()
+	iterator.pushLeftUntil(current.right, iterator.from)
+}
+
+func (iterator *aggregateIter[K, V, A]) Seek(key K) {
+	iterator.modifications.Verify()
+	iterator.stack = ADTStack.NewStack[*aggregateNode[K, V, A]]()
+	iterator.pushLeftUntil(iterator.tree.root, seekStart(key, iterator.from, iterator.tree.cmp))
+}
+
+// Delete removes the current node and, unless removing it rotated any node, resumes from the stack as the
+// iterator of a BST does. Rotations may move the stacked nodes, so in that case the key is sought again.
+func (iterator *aggregateIter[K, V, A]) Delete() V {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	current := iterator.stack.Peek()
+	key, right, replaced := current.key, current.right, current.left != nil && current.right != nil
+	removed, rotated := iterator.tree.remove(key)
+	iterator.modifications.Sync()
+	switch {
+	case rotated:
+		iterator.Seek(key)
+	case !replaced:
+		iterator.stack.Pop()
+		iterator.pushLeftUntil(right, iterator.from)
+	}
+	return removed
+}
@@ -0,0 +1,87 @@
+package mymap_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateMapEmpty(t *testing.T) {
+	dic := ADTMap.CreateAggregateMap(cmpInt, ADTMap.CountMonoid[int, int]())
+	require.EqualValues(t, 0, dic.Count())
+	require.EqualValues(t, 0, dic.AggregateRange(nil, nil))
+	require.Panics(t, func() { dic.Get(1) })
+	require.Panics(t, func() { dic.Remove(1) })
+
+	maxDic := ADTMap.CreateAggregateMap(cmpInt, ADTMap.MaxMonoid[int, int]())
+	require.Nil(t, maxDic.AggregateRange(nil, nil))
+}
+
+func TestAggregateMapMonoids(t *testing.T) {
+	count := ADTMap.CreateAggregateMap(cmpInt, ADTMap.CountMonoid[int, int]())
+	sum := ADTMap.CreateAggregateMap(cmpInt, ADTMap.SumMonoid[int, int]())
+	minimum := ADTMap.CreateAggregateMap(cmpInt, ADTMap.MinMonoid[int, int]())
+	maximum := ADTMap.CreateAggregateMap(cmpInt, ADTMap.MaxMonoid[int, int]())
+	for _, dic := range []ADTMap.BSTMap[int, int]{count, sum, minimum, maximum} {
+		for key, value := range []int{5, 3, 9, 1, 7, 2} {
+			dic.Save(key, value)
+		}
+	}
+
+	from, to := 1, 4
+	require.EqualValues(t, 4, count.AggregateRange(&from, &to))
+	require.EqualValues(t, 3+9+1+7, sum.AggregateRange(&from, &to))
+	require.EqualValues(t, 1, *minimum.AggregateRange(&from, &to))
+	require.EqualValues(t, 9, *maximum.AggregateRange(&from, &to))
+
+	sum.Save(2, 100)
+	require.EqualValues(t, 3+100+1+7, sum.AggregateRange(&from, &to), "Updating a value should update the aggregates")
+	sum.Remove(1)
+	require.EqualValues(t, 100+1+7, sum.AggregateRange(&from, &to), "Removing a key should update the aggregates")
+	require.EqualValues(t, 5+100+1+7+2, sum.AggregateRange(nil, nil))
+}
+
+func TestAggregateMapMatchesIteration(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	// Concatenating the keys is not commutative, so it also checks that the aggregates keep the key order
+	concat := ADTMap.Monoid[int, int, string]{
+		Identity: "",
+		Measure:  func(key int, _ int) string { return fmt.Sprint(key, ",") },
+		Combine:  func(a, b string) string { return a + b },
+	}
+	dic := ADTMap.CreateAggregateMap(cmpInt, concat)
+	bst := ADTMap.CreateBST[int, int](cmpInt)
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		if rng.Intn(3) == 0 && bst.Contains(key) {
+			require.Equal(t, bst.Remove(key), dic.Remove(key))
+		} else {
+			bst.Save(key, i)
+			dic.Save(key, i)
+		}
+	}
+	require.Equal(t, bst.Count(), dic.Count())
+
+	for i := 0; i < 300; i++ {
+		from := rng.Intn(520) - 10
+		to := from + rng.Intn(100)
+		expected := ""
+		bst.IterateRange(&from, &to, func(key int, _ int) bool {
+			expected += fmt.Sprint(key, ",")
+			return true
+		})
+		require.Equal(t, expected, dic.AggregateRange(&from, &to))
+	}
+
+	expected := ""
+	for iter := bst.Iterator(); iter.HasNext(); iter.Next() {
+		key, _ := iter.Current()
+		expected += fmt.Sprint(key, ",")
+	}
+	require.Equal(t, expected, dic.AggregateRange(nil, nil))
+}
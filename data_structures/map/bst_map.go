@@ -1,12 +1,20 @@
 package mymap
 
 import (
+	"cmp"
+	"reflect"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/compare"
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/arena"
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
 	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
 )
 
 const (
 	_KEY_NOT_FOUND   = "The key does not belong to the dictionary"
 	_ITERATOR_FINISH = "The iterator has finished iterating"
+	_LENGTH_MISMATCH = "The number of keys and values must be the same"
+	_UNKNOWN_MAP     = "The map was not created by this package"
 )
 
 // ===================== Types ==========================
@@ -20,83 +28,227 @@ type bstNode[K comparable, V any] struct {
 
 type cmpFunc[K comparable] func(K, K) int
 
+// ordered is implemented by the ordered maps of this package, exposing the comparator they were created with,
+// and by their synchronized wrappers, which return nil if the wrapped map does not expose one.
+type ordered[K comparable] interface {
+	comparator() cmpFunc[K]
+}
+
 type bst[K comparable, V any] struct {
 	root *bstNode[K, V]
 	size int
 	cmp  cmpFunc[K]
+	// nodes is the arena the nodes are allocated from, or nil if each one is allocated on its own
+	nodes         *arena.Arena[bstNode[K, V]]
+	modifications modifications.Counter
 }
 
 type bstIter[K comparable, V any] struct {
-	stack ADTStack.Stack[*bstNode[K, V]]
-	from  *K
-	to    *K
-	bst   *bst[K, V]
+	stack         ADTStack.Stack[*bstNode[K, V]]
+	from          *K
+	to            *K
+	bst           *bst[K, V]
+	modifications modifications.Check
 }
 
 // ===================== BST Helpers ==========================
 
-func createNode[K comparable, V any](key K, value V) *bstNode[K, V] {
-	node := new(bstNode[K, V])
+// createNode allocates a node for the pair, from the arena of the tree if it has one.
+func (bst *bst[K, V]) createNode(key K, value V) *bstNode[K, V] {
+	var node *bstNode[K, V]
+	if bst.nodes != nil {
+		node = bst.nodes.Alloc()
+	} else {
+		node = new(bstNode[K, V])
+	}
 	node.key = key
 	node.value = value
 	return node
 }
 
+// findLink returns the address of the pointer that references the node with the given key or, if the key
+// does not belong to the tree, of the nil pointer where it would be inserted.
+func (bst *bst[K, V]) findLink(key K) **bstNode[K, V] {
+	link := &bst.root
+	for *link != nil {
+		compare := bst.cmp((*link).key, key)
+		if compare > 0 {
+			link = &(*link).left
+		} else if compare < 0 {
+			link = &(*link).right
+		} else {
+			break
+		}
+	}
+	return link
+}
+
 func (bst *bst[K, V]) findNode(key K) *bstNode[K, V] {
 	if bst == nil {
 		return nil
 	}
-	return bst.root.findRecursive(key, bst.cmp)
+	return *bst.findLink(key)
 }
 
-func (node *bstNode[K, V]) findRecursive(key K, cmp func(K, K) int) *bstNode[K, V] {
-	if node == nil {
-		return nil
+// pushLeftUntil stacks the node and its left descendants, skipping the subtrees whose keys are all lower
+// than from. The next node to visit in order ends up at the top of the stack.
+func (bst *bst[K, V]) pushLeftUntil(stack ADTStack.Stack[*bstNode[K, V]], node *bstNode[K, V], from *K) {
+	for node != nil {
+		if from == nil || bst.cmp(node.key, *from) >= 0 {
+			stack.Push(node)
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+}
+
+// checkSorted returns ErrNotSorted if the keys are not sorted in strictly increasing order. It panics if there
+// are not as many values as keys.
+func checkSorted[K comparable, V any](keys []K, values []V, cmp cmpFunc[K]) error {
+	if len(keys) != len(values) {
+		panic(_LENGTH_MISMATCH)
+	}
+	if !isSorted(keys, cmp) {
+		return ErrNotSorted
 	}
-	compare := cmp(node.key, key)
-	if compare > 0 {
-		return node.left.findRecursive(key, cmp)
+	return nil
+}
+
+// comparatorOf returns the comparator of the map, or nil if it does not expose one.
+func comparatorOf[K comparable](dic any) cmpFunc[K] {
+	if orderedDic, ok := dic.(ordered[K]); ok {
+		return orderedDic.comparator()
 	}
-	if compare < 0 {
-		return node.right.findRecursive(key, cmp)
+	return nil
+}
+
+// buildBalanced builds a perfectly balanced subtree with the sorted keys, rooted at the middle one.
+func (bst *bst[K, V]) buildBalanced(keys []K, values []V) *bstNode[K, V] {
+	if len(keys) == 0 {
+		return nil
 	}
+	mid := len(keys) / 2
+	node := bst.createNode(keys[mid], values[mid])
+	node.left = bst.buildBalanced(keys[:mid], values[:mid])
+	node.right = bst.buildBalanced(keys[mid+1:], values[mid+1:])
 	return node
 }
 
+// seekStart returns the bound from which an iterator that seeks the given key must continue: the key itself,
+// unless it is lower than the beginning of the iterator's range.
+func seekStart[K comparable](key K, from *K, cmp cmpFunc[K]) *K {
+	if from != nil && cmp(*from, key) > 0 {
+		return from
+	}
+	return &key
+}
+
 // ===================== CreateBST ==========================
 
-func CreateBST[K comparable, V any](cmpFunc func(K, K) int) BSTMap[K, V] {
+func newBST[K comparable, V any](cmpFunc func(K, K) int, withArena bool) *bst[K, V] {
 	bst := new(bst[K, V])
 	bst.cmp = cmpFunc
+	if withArena {
+		bst.nodes = arena.New(func(node *bstNode[K, V]) **bstNode[K, V] { return &node.right })
+	}
 	return bst
 }
 
-// ===================== Save() =======================
+func CreateBST[K comparable, V any](cmpFunc func(K, K) int) BSTMap[K, V] {
+	return newBST[K, V](cmpFunc, false)
+}
 
-func (bst *bst[K, V]) Save(key K, value V) {
-	existingNode := bst.findNode(key)
-	if existingNode != nil {
-		existingNode.value = value
-		return
+// CreateArenaBST creates a BST whose nodes are allocated in slabs and reused once removed.
+func CreateArenaBST[K comparable, V any](cmpFunc func(K, K) int) BSTMap[K, V] {
+	return newBST[K, V](cmpFunc, true)
+}
+
+// CreateBSTOrdered creates a BST whose keys are ordered from the lowest to the greatest.
+func CreateBSTOrdered[K cmp.Ordered, V any]() BSTMap[K, V] {
+	return CreateBST[K, V](compare.Natural[K]())
+}
+
+// BSTFromSorted builds a perfectly balanced BST from keys sorted in strictly increasing order and their
+// associated values in O(n). It returns ErrNotSorted if the keys are not sorted, and panics if the number of
+// keys and values differ.
+func BSTFromSorted[K comparable, V any](keys []K, values []V, cmpFunc func(K, K) int) (BSTMap[K, V], error) {
+	if err := checkSorted(keys, values, cmpFunc); err != nil {
+		return nil, err
 	}
+	bst := newBST[K, V](cmpFunc, false)
+	bst.root = bst.buildBalanced(keys, values)
+	bst.size = len(keys)
+	return bst, nil
+}
 
-	saveRecursive(&bst.root, key, value, bst.cmp, &bst.size)
+// MergeBST builds a balanced BST with the elements of both maps in O(n + m), traversing them in order at the
+// same time. Both maps must be ordered maps of this package, or synchronized wrappers of them, so that their
+// comparators are known; otherwise, ErrUnknownComparator is returned. If they are not ordered by the same
+// comparator, ErrComparatorMismatch is returned. If a key belongs to both maps, its value is the result of
+// conflict.
+func MergeBST[K comparable, V any](a, b BSTMap[K, V], conflict func(key K, valueA V, valueB V) V) (BSTMap[K, V], error) {
+	cmp, cmpB := comparatorOf[K](a), comparatorOf[K](b)
+	if cmp == nil || cmpB == nil {
+		return nil, ErrUnknownComparator
+	}
+	// Functions cannot be compared, but the same comparator always has the same code. Closures made by the same
+	// function literal share it too, whatever they capture, so those are told apart by the order of the result.
+	if reflect.ValueOf(cmp).Pointer() != reflect.ValueOf(cmpB).Pointer() {
+		return nil, ErrComparatorMismatch
+	}
+
+	keys := make([]K, 0, a.Count()+b.Count())
+	values := make([]V, 0, a.Count()+b.Count())
+	iterA, iterB := a.Iterator(), b.Iterator()
+	for iterA.HasNext() || iterB.HasNext() {
+		if !iterB.HasNext() {
+			key, value := iterA.Current()
+			keys, values = append(keys, key), append(values, value)
+			iterA.Next()
+			continue
+		}
+		if !iterA.HasNext() {
+			key, value := iterB.Current()
+			keys, values = append(keys, key), append(values, value)
+			iterB.Next()
+			continue
+		}
+
+		keyA, valueA := iterA.Current()
+		keyB, valueB := iterB.Current()
+		compare := cmp(keyA, keyB)
+		if compare < 0 {
+			keys, values = append(keys, keyA), append(values, valueA)
+			iterA.Next()
+		} else if compare > 0 {
+			keys, values = append(keys, keyB), append(values, valueB)
+			iterB.Next()
+		} else {
+			keys, values = append(keys, keyA), append(values, conflict(keyA, valueA, valueB))
+			iterA.Next()
+			iterB.Next()
+		}
+	}
+
+	merged, err := BSTFromSorted(keys, values, cmp)
+	if err != nil {
+		return nil, ErrComparatorMismatch
+	}
+	return merged, nil
 }
 
-func saveRecursive[K comparable, V any](node **bstNode[K, V], key K, value V, cmp func(K, K) int, size *int) {
-	if *node == nil {
-		*size++
-		*node = createNode(key, value)
+// ===================== Save() =======================
+
+func (bst *bst[K, V]) Save(key K, value V) {
+	link := bst.findLink(key)
+	if *link != nil {
+		(*link).value = value
 		return
 	}
-	compare := cmp((*node).key, key)
-	if compare > 0 {
-		saveRecursive(&(*node).left, key, value, cmp, size)
-	} else if compare < 0 {
-		saveRecursive(&(*node).right, key, value, cmp, size)
-	} else {
-		(*node).value = value
-	}
+	*link = bst.createNode(key, value)
+	bst.size++
+	bst.modifications.Modified()
 }
 
 // ===================== Contains() ==========================
@@ -116,55 +268,58 @@ func (bst *bst[K, V]) Get(key K) V {
 	return node.value
 }
 
-// ===================== Remove() ==========================
-
-func (bst *bst[K, V]) Remove(key K) V {
+func (bst *bst[K, V]) TryGet(key K) (V, error) {
 	node := bst.findNode(key)
 	if node == nil {
-		panic(_KEY_NOT_FOUND)
+		var zero V
+		return zero, ErrKeyNotFound
 	}
-	removed := node.value
-	bst.removeRec(&bst.root, key)
-	bst.size--
-	return removed
+	return node.value, nil
 }
 
-func (bst *bst[K, V]) removeRec(node **bstNode[K, V], key K) {
-	if *node == nil {
-		panic(_KEY_NOT_FOUND)
-	}
+// ===================== Remove() ==========================
 
-	compare := bst.cmp(key, (*node).key)
-	if compare < 0 {
-		bst.removeRec(&(*node).left, key)
-	} else if compare > 0 {
-		bst.removeRec(&(*node).right, key)
-	} else {
-		*node = bst.deleteNode(*node)
+func (bst *bst[K, V]) Remove(key K) V {
+	link := bst.findLink(key)
+	if *link == nil {
+		panic(_KEY_NOT_FOUND)
 	}
+	removed := (*link).value
+	*link = bst.deleteNode(*link)
+	bst.size--
+	bst.modifications.Modified()
+	return removed
 }
 
+// deleteNode unlinks the node and returns the subtree that takes its place. The node that leaves the tree is
+// given back to the arena of the tree, if it has one.
 func (bst *bst[K, V]) deleteNode(node *bstNode[K, V]) *bstNode[K, V] {
-	if node.left == nil { // No children or only right child
-		return node.right
-	}
-	if node.right == nil { // Only left child
-		return node.left
+	if node.left == nil || node.right == nil { // At most one child, which takes its place
+		child := node.left
+		if child == nil {
+			child = node.right
+		}
+		bst.freeNode(node)
+		return child
 	}
 
-	// Two children
-	minNode := bst.minNode(node.right)
+	// Two children: the node takes the place of its successor, which is unlinked
+	minLink := &node.right
+	for (*minLink).left != nil {
+		minLink = &(*minLink).left
+	}
+	minNode := *minLink
 	node.key, node.value = minNode.key, minNode.value
-	bst.removeRec(&node.right, minNode.key)
+	*minLink = minNode.right
+	bst.freeNode(minNode)
 
 	return node
 }
 
-func (bst *bst[K, V]) minNode(node *bstNode[K, V]) *bstNode[K, V] {
-	for node.left != nil {
-		node = node.left
+func (bst *bst[K, V]) freeNode(node *bstNode[K, V]) {
+	if bst.nodes != nil {
+		bst.nodes.Free(node)
 	}
-	return node
 }
 
 // ===================== Count() ==========================
@@ -173,35 +328,32 @@ func (bst *bst[K, V]) Count() int {
 	return bst.size
 }
 
+func (bst *bst[K, V]) comparator() cmpFunc[K] {
+	return bst.cmp
+}
+
 // =================== Internal Iterator ===================
 
 func (bst *bst[K, V]) Iterate(visit func(key K, value V) bool) {
-	if bst == nil {
-		return
-	}
-	bst.root.iterateRecursive(nil, nil, visit, bst.cmp)
+	bst.IterateRange(nil, nil, visit)
 }
 
 func (bst *bst[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
-	if bst != nil {
-		bst.root.iterateRecursive(from, to, visit, bst.cmp)
-	}
-}
-
-func (node *bstNode[K, V]) iterateRecursive(from *K, to *K, visit func(key K, value V) bool, cmp func(K, K) int) bool {
-	if node == nil {
-		return true
-	}
-	if (from == nil || cmp(*from, node.key) < 0) && !node.left.iterateRecursive(from, to, visit, cmp) {
-		return false
-	}
-	if (from == nil || cmp(*from, node.key) <= 0) && (to == nil || cmp(node.key, *to) <= 0) && !visit(node.key, node.value) {
-		return false
+	if bst == nil {
+		return
 	}
-	if (to == nil || cmp(node.key, *to) < 0) && !node.right.iterateRecursive(from, to, visit, cmp) {
-		return false
+	stack := ADTStack.NewStack[*bstNode[K, V]]()
+	bst.pushLeftUntil(stack, bst.root, from)
+	for !stack.IsEmpty() {
+		node := stack.Pop()
+		if to != nil && bst.cmp(node.key, *to) > 0 {
+			return
+		}
+		if !visit(node.key, node.value) {
+			return
+		}
+		bst.pushLeftUntil(stack, node.right, from)
 	}
-	return true
 }
 
 // =================== External Iterator ===================
@@ -214,27 +366,16 @@ func (bst *bst[K, V]) IteratorRange(from *K, to *K) MapIterator[K, V] {
 	iterator := new(bstIter[K, V])
 	iterator.stack = ADTStack.NewStack[*bstNode[K, V]]()
 	iterator.bst = bst
+	iterator.modifications = modifications.NewCheck(&bst.modifications, _CONCURRENT_MODIFICATION)
 	iterator.from = from
 	iterator.to = to
 
-	if bst.root != nil {
-		iterator.pushLeftUntil(bst.root)
-	}
+	bst.pushLeftUntil(iterator.stack, bst.root, from)
 	return iterator
 }
 
-func (iterator *bstIter[K, V]) pushLeftUntil(node *bstNode[K, V]) {
-	for node != nil {
-		if iterator.from == nil || iterator.bst.cmp(node.key, *iterator.from) >= 0 {
-			iterator.stack.Push(node)
-			node = node.left
-		} else {
-			node = node.right
-		}
-	}
-}
-
 func (iterator *bstIter[K, V]) HasNext() bool {
+	iterator.modifications.Verify()
 	for !iterator.stack.IsEmpty() {
 		current := iterator.stack.Peek()
 		if iterator.to != nil && iterator.bst.cmp(current.key, *iterator.to) > 0 {
@@ -260,7 +401,29 @@ func (iterator *bstIter[K, V]) Next() {
 	}
 
 	current := iterator.stack.Pop()
-	if current.right != nil {
-		iterator.pushLeftUntil(current.right)
+	iterator.bst.pushLeftUntil(iterator.stack, current.right, iterator.from)
+}
+
+func (iterator *bstIter[K, V]) Seek(key K) {
+	iterator.modifications.Verify()
+	iterator.stack = ADTStack.NewStack[*bstNode[K, V]]()
+	iterator.bst.pushLeftUntil(iterator.stack, iterator.bst.root, seekStart(key, iterator.from, iterator.bst.cmp))
+}
+
+// Delete removes the current node and resumes from the stack. A node with two children takes the key of its
+// successor, so it stays on top as the next element. Any other node leaves the tree, and the next elements
+// are the lowest keys of its right subtree.
+func (iterator *bstIter[K, V]) Delete() V {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
 	}
+	current := iterator.stack.Peek()
+	right, replaced := current.right, current.left != nil && current.right != nil
+	removed := iterator.bst.Remove(current.key)
+	iterator.modifications.Sync()
+	if !replaced {
+		iterator.stack.Pop()
+		iterator.bst.pushLeftUntil(iterator.stack, right, iterator.from)
+	}
+	return removed
 }
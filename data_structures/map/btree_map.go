@@ -0,0 +1,506 @@
+package mymap
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+)
+
+const (
+	_MIN_DEGREE     = 2
+	_INVALID_DEGREE = "The degree of a B-tree must be at least 2"
+)
+
+// ===================== Types ==========================
+
+// bTreeNode stores between degree-1 and 2*degree-1 sorted keys (the root may have fewer).
+// Internal nodes have exactly one more child than keys.
+type bTreeNode[K comparable, V any] struct {
+	keys     []K
+	values   []V
+	children []*bTreeNode[K, V]
+}
+
+type bTree[K comparable, V any] struct {
+	root          *bTreeNode[K, V]
+	degree        int
+	size          int
+	cmp           cmpFunc[K]
+	modifications modifications.Counter
+}
+
+// bTreeFrame is a position inside a node: the next key to visit in that node is keys[index].
+type bTreeFrame[K comparable, V any] struct {
+	node  *bTreeNode[K, V]
+	index int
+}
+
+type bTreeIter[K comparable, V any] struct {
+	stack         ADTStack.Stack[*bTreeFrame[K, V]]
+	from          *K
+	to            *K
+	tree          *bTree[K, V]
+	modifications modifications.Check
+}
+
+// ===================== Slice Helpers ==========================
+
+func insertAt[T any](data []T, index int, element T) []T {
+	var zero T
+	data = append(data, zero)
+	copy(data[index+1:], data[index:])
+	data[index] = element
+	return data
+}
+
+func removeAt[T any](data []T, index int) []T {
+	var zero T
+	copy(data[index:], data[index+1:])
+	data[len(data)-1] = zero
+	return data[:len(data)-1]
+}
+
+// ===================== B-Tree Helpers ==========================
+
+func (node *bTreeNode[K, V]) isLeaf() bool {
+	return len(node.children) == 0
+}
+
+// search returns the index of the first key of the node that is greater than or equal to the given key,
+// and whether that key is equal to it.
+func (node *bTreeNode[K, V]) search(key K, cmp cmpFunc[K]) (int, bool) {
+	low, high := 0, len(node.keys)
+	for low < high {
+		mid := (low + high) / 2
+		if cmp(node.keys[mid], key) < 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low, low < len(node.keys) && cmp(node.keys[low], key) == 0
+}
+
+func (tree *bTree[K, V]) findNode(key K) (*bTreeNode[K, V], int) {
+	node := tree.root
+	for {
+		index, found := node.search(key, tree.cmp)
+		if found {
+			return node, index
+		}
+		if node.isLeaf() {
+			return nil, 0
+		}
+		node = node.children[index]
+	}
+}
+
+func (tree *bTree[K, V]) isFull(node *bTreeNode[K, V]) bool {
+	return len(node.keys) == 2*tree.degree-1
+}
+
+// splitChild splits the full child at the given index around its median key, which moves up to the parent.
+func (tree *bTree[K, V]) splitChild(parent *bTreeNode[K, V], index int) {
+	child := parent.children[index]
+	mid := tree.degree - 1
+
+	right := new(bTreeNode[K, V])
+	right.keys = append([]K(nil), child.keys[mid+1:]...)
+	right.values = append([]V(nil), child.values[mid+1:]...)
+	if !child.isLeaf() {
+		right.children = append([]*bTreeNode[K, V](nil), child.children[mid+1:]...)
+		clear(child.children[mid+1:])
+		child.children = child.children[:mid+1]
+	}
+
+	parent.keys = insertAt(parent.keys, index, child.keys[mid])
+	parent.values = insertAt(parent.values, index, child.values[mid])
+	parent.children = insertAt(parent.children, index+1, right)
+
+	clear(child.keys[mid:])
+	clear(child.values[mid:])
+	child.keys = child.keys[:mid]
+	child.values = child.values[:mid]
+}
+
+// mergeChildren merges the child at index+1 and the separating key into the child at index.
+func (node *bTreeNode[K, V]) mergeChildren(index int) {
+	left, right := node.children[index], node.children[index+1]
+	left.keys = append(append(left.keys, node.keys[index]), right.keys...)
+	left.values = append(append(left.values, node.values[index]), right.values...)
+	left.children = append(left.children, right.children...)
+
+	node.keys = removeAt(node.keys, index)
+	node.values = removeAt(node.values, index)
+	node.children = removeAt(node.children, index+1)
+}
+
+// borrowFromLeft moves the last key of the left sibling up to the parent, and the separating key
+// down to the front of the child at index.
+func (node *bTreeNode[K, V]) borrowFromLeft(index int) {
+	child, sibling := node.children[index], node.children[index-1]
+	last := len(sibling.keys) - 1
+
+	child.keys = insertAt(child.keys, 0, node.keys[index-1])
+	child.values = insertAt(child.values, 0, node.values[index-1])
+	node.keys[index-1], node.values[index-1] = sibling.keys[last], sibling.values[last]
+	sibling.keys = removeAt(sibling.keys, last)
+	sibling.values = removeAt(sibling.values, last)
+
+	if !sibling.isLeaf() {
+		child.children = insertAt(child.children, 0, sibling.children[last+1])
+		sibling.children = removeAt(sibling.children, last+1)
+	}
+}
+
+// borrowFromRight moves the first key of the right sibling up to the parent, and the separating key
+// down to the end of the child at index.
+func (node *bTreeNode[K, V]) borrowFromRight(index int) {
+	child, sibling := node.children[index], node.children[index+1]
+
+	child.keys = append(child.keys, node.keys[index])
+	child.values = append(child.values, node.values[index])
+	node.keys[index], node.values[index] = sibling.keys[0], sibling.values[0]
+	sibling.keys = removeAt(sibling.keys, 0)
+	sibling.values = removeAt(sibling.values, 0)
+
+	if !sibling.isLeaf() {
+		child.children = append(child.children, sibling.children[0])
+		sibling.children = removeAt(sibling.children, 0)
+	}
+}
+
+// ===================== CreateBTree ==========================
+
+// CreateBTree creates an ordered map backed by a B-tree of the given minimum degree: every node other than
+// the root holds between degree-1 and 2*degree-1 keys. It panics if the degree is less than 2.
+func CreateBTree[K comparable, V any](cmpFunc func(K, K) int, degree int) BSTMap[K, V] {
+	return newBTree[K, V](cmpFunc, degree)
+}
+
+func newBTree[K comparable, V any](cmpFunc func(K, K) int, degree int) *bTree[K, V] {
+	if degree < _MIN_DEGREE {
+		panic(_INVALID_DEGREE)
+	}
+	tree := new(bTree[K, V])
+	tree.root = new(bTreeNode[K, V])
+	tree.degree = degree
+	tree.cmp = cmpFunc
+	return tree
+}
+
+// CreateBTreeFromSorted builds a B-tree of the given degree from keys sorted in strictly increasing order
+// and their associated values in O(n). It returns ErrNotSorted if the keys are not sorted, and panics if the
+// number of keys and values differ.
+func CreateBTreeFromSorted[K comparable, V any](keys []K, values []V, cmpFunc func(K, K) int, degree int) (BSTMap[K, V], error) {
+	if err := checkSorted(keys, values, cmpFunc); err != nil {
+		return nil, err
+	}
+	tree := newBTree[K, V](cmpFunc, degree)
+	if len(keys) == 0 {
+		return tree, nil
+	}
+	height, capacity := 1, 2*degree
+	for capacity-1 < len(keys) {
+		height++
+		capacity *= 2 * degree
+	}
+	tree.root = tree.buildSorted(keys, values, height, capacity/(2*degree), true)
+	tree.size = len(keys)
+	return tree, nil
+}
+
+// buildSorted builds a subtree of the given height holding all the keys. childCapacity is one more than
+// the maximum number of keys a subtree of height-1 can hold. The keys are spread evenly among the children,
+// which keeps every node within the degree bounds.
+func (tree *bTree[K, V]) buildSorted(keys []K, values []V, height int, childCapacity int, isRoot bool) *bTreeNode[K, V] {
+	node := new(bTreeNode[K, V])
+	if height == 1 {
+		node.keys = append([]K(nil), keys...)
+		node.values = append([]V(nil), values...)
+		return node
+	}
+
+	children := (len(keys) + childCapacity) / childCapacity
+	minChildren := tree.degree
+	if isRoot {
+		minChildren = 2
+	}
+	children = max(children, minChildren)
+
+	perChild, extra := (len(keys)-children+1)/children, (len(keys)-children+1)%children
+	start := 0
+	for i := 0; i < children; i++ {
+		end := start + perChild
+		if i < extra {
+			end++
+		}
+		child := tree.buildSorted(keys[start:end], values[start:end], height-1, childCapacity/(2*tree.degree), false)
+		node.children = append(node.children, child)
+		if i < children-1 {
+			node.keys = append(node.keys, keys[end])
+			node.values = append(node.values, values[end])
+		}
+		start = end + 1
+	}
+	return node
+}
+
+// ===================== Save() =======================
+
+func (tree *bTree[K, V]) Save(key K, value V) {
+	if node, index := tree.findNode(key); node != nil {
+		node.values[index] = value
+		return
+	}
+
+	if tree.isFull(tree.root) {
+		newRoot := new(bTreeNode[K, V])
+		newRoot.children = []*bTreeNode[K, V]{tree.root}
+		tree.splitChild(newRoot, 0)
+		tree.root = newRoot
+	}
+
+	node := tree.root
+	for {
+		index, _ := node.search(key, tree.cmp)
+		if node.isLeaf() {
+			node.keys = insertAt(node.keys, index, key)
+			node.values = insertAt(node.values, index, value)
+			break
+		}
+		if tree.isFull(node.children[index]) {
+			tree.splitChild(node, index)
+			if tree.cmp(key, node.keys[index]) > 0 {
+				index++
+			}
+		}
+		node = node.children[index]
+	}
+	tree.size++
+	tree.modifications.Modified()
+}
+
+// ===================== Contains() ==========================
+
+func (tree *bTree[K, V]) Contains(key K) bool {
+	node, _ := tree.findNode(key)
+	return node != nil
+}
+
+// ===================== Get() ==========================
+
+func (tree *bTree[K, V]) Get(key K) V {
+	node, index := tree.findNode(key)
+	if node == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	return node.values[index]
+}
+
+func (tree *bTree[K, V]) TryGet(key K) (V, error) {
+	node, index := tree.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return node.values[index], nil
+}
+
+// ===================== Remove() ==========================
+
+func (tree *bTree[K, V]) Remove(key K) V {
+	removed, _ := tree.remove(key)
+	return removed
+}
+
+// remove descends from the root making sure that every node it enters has at least degree keys,
+// so that a key can always be taken out of a leaf without further rebalancing. It also reports whether
+// it moved keys between nodes, or only took the key out of its leaf.
+func (tree *bTree[K, V]) remove(key K) (V, bool) {
+	target, targetIndex := tree.findNode(key)
+	if target == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	removed := target.values[targetIndex]
+
+	node, restructured := tree.root, false
+	for {
+		index, found := node.search(key, tree.cmp)
+		if node.isLeaf() {
+			node.keys = removeAt(node.keys, index)
+			node.values = removeAt(node.values, index)
+			break
+		}
+
+		if found {
+			restructured = true
+			left, right := node.children[index], node.children[index+1]
+			if len(left.keys) >= tree.degree {
+				predecessor := left
+				for !predecessor.isLeaf() {
+					predecessor = predecessor.children[len(predecessor.children)-1]
+				}
+				last := len(predecessor.keys) - 1
+				node.keys[index], node.values[index] = predecessor.keys[last], predecessor.values[last]
+				key, node = predecessor.keys[last], left
+			} else if len(right.keys) >= tree.degree {
+				successor := right
+				for !successor.isLeaf() {
+					successor = successor.children[0]
+				}
+				node.keys[index], node.values[index] = successor.keys[0], successor.values[0]
+				key, node = successor.keys[0], right
+			} else {
+				node.mergeChildren(index)
+				node = left
+			}
+			continue
+		}
+
+		if len(node.children[index].keys) < tree.degree {
+			restructured = true
+			if index > 0 && len(node.children[index-1].keys) >= tree.degree {
+				node.borrowFromLeft(index)
+			} else if index < len(node.children)-1 && len(node.children[index+1].keys) >= tree.degree {
+				node.borrowFromRight(index)
+			} else if index < len(node.children)-1 {
+				node.mergeChildren(index)
+			} else {
+				node.mergeChildren(index - 1)
+				index--
+			}
+		}
+		node = node.children[index]
+	}
+
+	if len(tree.root.keys) == 0 && !tree.root.isLeaf() {
+		tree.root = tree.root.children[0]
+	}
+	tree.size--
+	tree.modifications.Modified()
+	return removed, restructured
+}
+
+// ===================== Count() ==========================
+
+func (tree *bTree[K, V]) Count() int {
+	return tree.size
+}
+
+func (tree *bTree[K, V]) comparator() cmpFunc[K] {
+	return tree.cmp
+}
+
+// =================== Internal Iterator ===================
+
+func (tree *bTree[K, V]) Iterate(visit func(key K, value V) bool) {
+	tree.root.iterateRange(nil, nil, visit, tree.cmp)
+}
+
+func (tree *bTree[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	tree.root.iterateRange(from, to, visit, tree.cmp)
+}
+
+func (node *bTreeNode[K, V]) iterateRange(from *K, to *K, visit func(key K, value V) bool, cmp cmpFunc[K]) bool {
+	start := 0
+	if from != nil {
+		start, _ = node.search(*from, cmp)
+	}
+	for i := start; i <= len(node.keys); i++ {
+		if !node.isLeaf() && !node.children[i].iterateRange(from, to, visit, cmp) {
+			return false
+		}
+		if i == len(node.keys) {
+			break
+		}
+		if to != nil && cmp(node.keys[i], *to) > 0 {
+			return false
+		}
+		if !visit(node.keys[i], node.values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// =================== External Iterator ===================
+
+func (tree *bTree[K, V]) Iterator() MapIterator[K, V] {
+	return tree.IteratorRange(nil, nil)
+}
+
+func (tree *bTree[K, V]) IteratorRange(from *K, to *K) MapIterator[K, V] {
+	iterator := new(bTreeIter[K, V])
+	iterator.stack = ADTStack.NewStack[*bTreeFrame[K, V]]()
+	iterator.tree = tree
+	iterator.modifications = modifications.NewCheck(&tree.modifications, _CONCURRENT_MODIFICATION)
+	iterator.from = from
+	iterator.to = to
+	iterator.pushLeftUntil(tree.root, from)
+	return iterator
+}
+
+// pushLeftUntil stacks the path from the node down to the first key greater than or equal to from
+// (or to the leftmost key if from is nil).
+func (iterator *bTreeIter[K, V]) pushLeftUntil(node *bTreeNode[K, V], from *K) {
+	for node != nil {
+		index, found := 0, false
+		if from != nil {
+			index, found = node.search(*from, iterator.tree.cmp)
+		}
+		iterator.stack.Push(&bTreeFrame[K, V]{node: node, index: index})
+		if found || node.isLeaf() {
+			return
+		}
+		node = node.children[index]
+	}
+}
+
+func (iterator *bTreeIter[K, V]) HasNext() bool {
+	iterator.modifications.Verify()
+	for !iterator.stack.IsEmpty() {
+		frame := iterator.stack.Peek()
+		if frame.index < len(frame.node.keys) {
+			return iterator.to == nil || iterator.tree.cmp(frame.node.keys[frame.index], *iterator.to) <= 0
+		}
+		iterator.stack.Pop()
+	}
+	return false
+}
+
+func (iterator *bTreeIter[K, V]) Current() (K, V) {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	frame := iterator.stack.Peek()
+	return frame.node.keys[frame.index], frame.node.values[frame.index]
+}
+
+func (iterator *bTreeIter[K, V]) Next() {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	frame := iterator.stack.Peek()
+	frame.index++
+	if !frame.node.isLeaf() {
+		iterator.pushLeftUntil(frame.node.children[frame.index], nil)
+	}
+}
+
+func (iterator *bTreeIter[K, V]) Seek(key K) {
+	iterator.modifications.Verify()
+	iterator.stack = ADTStack.NewStack[*bTreeFrame[K, V]]()
+	iterator.pushLeftUntil(iterator.tree.root, seekStart(key, iterator.from, iterator.tree.cmp))
+}
+
+// Delete removes the current key. If it was taken out of its leaf and no other node changed, the stacked path
+// is still valid and the next key has taken its place in the leaf. Otherwise, the key is sought again.
+func (iterator *bTreeIter[K, V]) Delete() V {
+	key, _ := iterator.Current()
+	removed, restructured := iterator.tree.remove(key)
+	iterator.modifications.Sync()
+	if restructured {
+		iterator.Seek(key)
+	}
+	return removed
+}
@@ -0,0 +1,211 @@
+package mymap_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_BTREE_DEGREES    = []int{2, 3, 16}
+	_BENCH_BTREE_SIZE = []int{1000000}
+)
+
+const _BENCH_BTREE_DEGREE = 32
+
+func requireSameContents(t *testing.T, expected, actual ADTMap.BSTMap[int, int]) {
+	require.Equal(t, expected.Count(), actual.Count())
+	iterExpected, iterActual := expected.Iterator(), actual.Iterator()
+	for iterExpected.HasNext() {
+		require.True(t, iterActual.HasNext())
+		keyExpected, valueExpected := iterExpected.Current()
+		keyActual, valueActual := iterActual.Current()
+		require.Equal(t, keyExpected, keyActual)
+		require.Equal(t, valueExpected, valueActual)
+		iterExpected.Next()
+		iterActual.Next()
+	}
+	require.False(t, iterActual.HasNext())
+}
+
+func TestBTreeInvalidDegree(t *testing.T) {
+	require.Panics(t, func() { ADTMap.CreateBTree[int, int](cmpInt, 1) })
+}
+
+func TestBTreeEmpty(t *testing.T) {
+	tree := ADTMap.CreateBTree[int, string](cmpInt, 2)
+	require.EqualValues(t, 0, tree.Count())
+	require.False(t, tree.Contains(0))
+	require.Panics(t, func() { tree.Get(0) })
+	require.Panics(t, func() { tree.Remove(0) })
+
+	iter := tree.Iterator()
+	require.False(t, iter.HasNext())
+	require.Panics(t, func() { iter.Current() })
+	require.Panics(t, func() { iter.Next() })
+}
+
+func TestBTreeMatchesBST(t *testing.T) {
+	for _, degree := range _BTREE_DEGREES {
+		rng := rand.New(rand.NewSource(int64(degree)))
+		tree := ADTMap.CreateBTree[int, int](cmpInt, degree)
+		bst := ADTMap.CreateBST[int, int](cmpInt)
+
+		for i := 0; i < 20000; i++ {
+			key := rng.Intn(1000)
+			if rng.Intn(2) == 0 && bst.Contains(key) {
+				require.Equal(t, bst.Remove(key), tree.Remove(key))
+			} else {
+				tree.Save(key, i)
+				bst.Save(key, i)
+			}
+			require.Equal(t, bst.Count(), tree.Count())
+		}
+		requireSameContents(t, bst, tree)
+
+		for _, key := range rng.Perm(1000) {
+			if bst.Contains(key) {
+				require.Equal(t, bst.Remove(key), tree.Remove(key))
+			}
+		}
+		require.EqualValues(t, 0, tree.Count())
+		require.False(t, tree.Iterator().HasNext())
+	}
+}
+
+func TestBTreeRanges(t *testing.T) {
+	tree := ADTMap.CreateBTree[int, string](cmpInt, 2)
+	for i := 0; i < 100; i += 2 {
+		tree.Save(i, fmt.Sprint(i))
+	}
+
+	from, to := 15, 31
+	expected := []int{16, 18, 20, 22, 24, 26, 28, 30}
+
+	keys := []int{}
+	tree.IterateRange(&from, &to, func(key int, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, expected, keys)
+
+	keys = []int{}
+	for iter := tree.IteratorRange(&from, &to); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.Equal(t, fmt.Sprint(key), value)
+		keys = append(keys, key)
+	}
+	require.Equal(t, expected, keys)
+
+	from, to = 16, 16
+	keys = []int{}
+	for iter := tree.IteratorRange(&from, &to); iter.HasNext(); iter.Next() {
+		key, _ := iter.Current()
+		keys = append(keys, key)
+	}
+	require.Equal(t, []int{16}, keys, "A range with the same bounds should only include that key")
+
+	keys = []int{}
+	tree.IterateRange(nil, nil, func(key int, _ string) bool {
+		keys = append(keys, key)
+		return key < 10
+	})
+	require.Equal(t, []int{0, 2, 4, 6, 8, 10}, keys, "The iteration should stop when visit returns false")
+}
+
+func TestBTreeFromSorted(t *testing.T) {
+	for _, degree := range _BTREE_DEGREES {
+		for _, n := range []int{0, 1, 2, 7, 100, 5000} {
+			keys, values := make([]int, n), make([]int, n)
+			bst := ADTMap.CreateBST[int, int](cmpInt)
+			for i := range n {
+				keys[i], values[i] = i*3, i
+				bst.Save(keys[i], values[i])
+			}
+
+			tree, err := ADTMap.CreateBTreeFromSorted(keys, values, cmpInt, degree)
+			require.NoError(t, err)
+			requireSameContents(t, bst, tree)
+
+			// The tree built in bulk should keep working after further modifications
+			for i := 0; i < n; i += 2 {
+				require.Equal(t, bst.Remove(keys[i]), tree.Remove(keys[i]))
+				bst.Save(keys[i]+1, i)
+				tree.Save(keys[i]+1, i)
+			}
+			requireSameContents(t, bst, tree)
+		}
+	}
+}
+
+func TestBTreeFromSortedInvalidInput(t *testing.T) {
+	for _, keys := range [][]int{{1, 3, 2}, {1, 1}} {
+		tree, err := ADTMap.CreateBTreeFromSorted(keys, make([]int, len(keys)), cmpInt, 2)
+		require.ErrorIs(t, err, ADTMap.ErrNotSorted)
+		require.Nil(t, tree)
+	}
+	require.Panics(t, func() { ADTMap.CreateBTreeFromSorted([]int{1, 2}, []int{0}, cmpInt, 2) })
+}
+
+func benchmarkOrderedMap(b *testing.B, create func() ADTMap.BSTMap[int, int]) {
+	for _, n := range _BENCH_BTREE_SIZE {
+		keys := rand.New(rand.NewSource(int64(n))).Perm(n)
+		b.Run(fmt.Sprintf("Save %d elements", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tree := create()
+				for _, key := range keys {
+					tree.Save(key, key)
+				}
+			}
+		})
+
+		tree := create()
+		for _, key := range keys {
+			tree.Save(key, key)
+		}
+		b.Run(fmt.Sprintf("Get %d elements", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, key := range keys {
+					tree.Get(key)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("Iterate %d elements", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for iter := tree.Iterator(); iter.HasNext(); iter.Next() {
+					iter.Current()
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkBTree(b *testing.B) {
+	benchmarkOrderedMap(b, func() ADTMap.BSTMap[int, int] {
+		return ADTMap.CreateBTree[int, int](cmpInt, _BENCH_BTREE_DEGREE)
+	})
+}
+
+func BenchmarkBSTAgainstBTree(b *testing.B) {
+	benchmarkOrderedMap(b, func() ADTMap.BSTMap[int, int] {
+		return ADTMap.CreateBST[int, int](cmpInt)
+	})
+}
+
+func BenchmarkBTreeFromSorted(b *testing.B) {
+	for _, n := range _BENCH_BTREE_SIZE {
+		keys := make([]int, n)
+		for i := range n {
+			keys[i] = i
+		}
+		b.Run(fmt.Sprintf("Build %d elements", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ADTMap.CreateBTreeFromSorted(keys, keys, cmpInt, _BENCH_BTREE_DEGREE)
+			}
+		})
+	}
+}
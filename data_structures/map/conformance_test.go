@@ -0,0 +1,41 @@
+package mymap_test
+
+import (
+	"testing"
+
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+	"github.com/sebagarciad/algorithms-and-data-structures/map/maptest"
+)
+
+func TestMapsConformance(t *testing.T) {
+	t.Run("Hash", func(t *testing.T) {
+		maptest.RunConformance(t, ADTMap.NewHash[int, int])
+	})
+	t.Run("LinkedHash", func(t *testing.T) {
+		maptest.RunConformance(t, ADTMap.NewLinkedHash[int, int])
+	})
+}
+
+func TestOrderedMapsConformance(t *testing.T) {
+	factories := map[string]func(cmp func(a, b int) int) ADTMap.BSTMap[int, int]{
+		"BST":      ADTMap.CreateBST[int, int],
+		"ArenaBST": ADTMap.CreateArenaBST[int, int],
+		"SkipList": func(cmp func(a, b int) int) ADTMap.BSTMap[int, int] {
+			return ADTMap.CreateSkipListWithSeed[int, int](cmp, 1)
+		},
+		"BTree": func(cmp func(a, b int) int) ADTMap.BSTMap[int, int] {
+			return ADTMap.CreateBTree[int, int](cmp, 2)
+		},
+		"Treap": func(cmp func(a, b int) int) ADTMap.BSTMap[int, int] {
+			return ADTMap.CreateTreapWithSeed[int, int](cmp, 1)
+		},
+		"Aggregate": func(cmp func(a, b int) int) ADTMap.BSTMap[int, int] {
+			return ADTMap.CreateAggregateMap(cmp, ADTMap.CountMonoid[int, int]())
+		},
+	}
+	for name, factory := range factories {
+		t.Run(name, func(t *testing.T) {
+			maptest.RunOrderedConformance(t, factory)
+		})
+	}
+}
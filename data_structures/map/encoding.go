@@ -0,0 +1,327 @@
+package mymap
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+)
+
+// Every map implements codec.Serializable, and is encoded as its sequence of key-value pairs, in iteration
+// order: MarshalWith with the default codecs of the keys and values for the binary form, and
+// codec.MarshalPairsJSON for JSON. Unmarshalling replaces the contents of the map, keeping its comparator and
+// configuration, and leaves the map untouched if the data is not valid.
+
+// MarshalWith encodes the pairs of the map in binary, in iteration order, with the given codecs for the keys
+// and the values.
+func MarshalWith[K comparable, V any](dic Map[K, V], keys codec.Codec[K], values codec.Codec[V]) ([]byte, error) {
+	snapshot := pairsOf(dic)
+	return codec.MarshalPairs(len(snapshot.keys), snapshot.iterate, keys, values)
+}
+
+// UnmarshalWith replaces the contents of the map with the pairs encoded by MarshalWith with the same codecs.
+// If the data is not valid, the map is left untouched.
+func UnmarshalWith[K comparable, V any](dic Map[K, V], data []byte, keys codec.Codec[K], values codec.Codec[V]) error {
+	decoded := new(pairs[K, V])
+	if err := codec.UnmarshalPairs(data, keys, values, decoded.add); err != nil {
+		return err
+	}
+	replaceContents(dic, decoded.keys, decoded.values)
+	return nil
+}
+
+// ===================== Encoding Helpers ==========================
+
+// pairs holds key-value pairs in the order they were added.
+type pairs[K comparable, V any] struct {
+	keys   []K
+	values []V
+}
+
+// pairsOf returns the pairs of the map, visited in a single traversal.
+func pairsOf[K comparable, V any](dic Map[K, V]) *pairs[K, V] {
+	visited := new(pairs[K, V])
+	dic.Iterate(func(key K, value V) bool {
+		visited.add(key, value)
+		return true
+	})
+	return visited
+}
+
+func (pairs *pairs[K, V]) add(key K, value V) {
+	pairs.keys = append(pairs.keys, key)
+	pairs.values = append(pairs.values, value)
+}
+
+func (pairs *pairs[K, V]) iterate(visit func(key K, value V) bool) {
+	for i := range pairs.keys {
+		if !visit(pairs.keys[i], pairs.values[i]) {
+			return
+		}
+	}
+}
+
+// loader is implemented by the maps of this package, which rebuild themselves from the decoded pairs keeping
+// their configuration.
+type loader[K comparable, V any] interface {
+	load(keys []K, values []V)
+}
+
+// replaceContents replaces the pairs of the map, all at once if the map is synchronized.
+func replaceContents[K comparable, V any](dic Map[K, V], keys []K, values []V) {
+	switch dic := dic.(type) {
+	case loader[K, V]:
+		dic.load(keys, values)
+	case SynchronizedMap[K, V]:
+		dic.Update(func(dic Map[K, V]) { replaceContents(dic, keys, values) })
+	case SynchronizedBSTMap[K, V]:
+		dic.Update(func(tree BSTMap[K, V]) { replaceContents[K, V](tree, keys, values) })
+	default:
+		for _, key := range pairsOf(dic).keys {
+			dic.Remove(key)
+		}
+		fill(dic, keys, values)
+	}
+}
+
+func unmarshalJSON[K comparable, V any](dic Map[K, V], data []byte) error {
+	decoded := new(pairs[K, V])
+	if err := codec.UnmarshalPairsJSON(data, decoded.add); err != nil {
+		return err
+	}
+	replaceContents(dic, decoded.keys, decoded.values)
+	return nil
+}
+
+func fill[K comparable, V any](dic Map[K, V], keys []K, values []V) {
+	for i := range keys {
+		dic.Save(keys[i], values[i])
+	}
+}
+
+func isSorted[K comparable](keys []K, cmp cmpFunc[K]) bool {
+	for i := 1; i < len(keys); i++ {
+		if cmp(keys[i-1], keys[i]) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ===================== Hash ==========================
+
+func (hash *closedHash[K, V]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[K, V](hash, codec.Default[K](), codec.Default[V]())
+}
+
+func (hash *closedHash[K, V]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalPairsJSON(hash.Count(), hash.Iterate)
+}
+
+func (hash *closedHash[K, V]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[K, V](hash, data, codec.Default[K](), codec.Default[V]())
+}
+
+func (hash *closedHash[K, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[K, V](hash, data)
+}
+
+func (hash *closedHash[K, V]) load(keys []K, values []V) {
+	decoded := newClosedHash[K, V](hash.options)
+	decoded.resizes += hash.resizes
+	fill[K, V](decoded, keys, values)
+	decoded.modifications.Replaces(hash.modifications)
+	*hash = *decoded
+}
+
+// ===================== Linked Hash ==========================
+
+func (hash *linkedHash[K, V]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[K, V](hash, codec.Default[K](), codec.Default[V]())
+}
+
+func (hash *linkedHash[K, V]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalPairsJSON(hash.Count(), hash.Iterate)
+}
+
+func (hash *linkedHash[K, V]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[K, V](hash, data, codec.Default[K](), codec.Default[V]())
+}
+
+func (hash *linkedHash[K, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[K, V](hash, data)
+}
+
+func (hash *linkedHash[K, V]) load(keys []K, values []V) {
+	decoded := newLinkedHash[K, V](hash.accessOrder)
+	fill[K, V](decoded, keys, values)
+	decoded.modifications.Replaces(hash.modifications)
+	*hash = *decoded
+}
+
+// ===================== BST ==========================
+
+func (bst *bst[K, V]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[K, V](bst, codec.Default[K](), codec.Default[V]())
+}
+
+func (bst *bst[K, V]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalPairsJSON(bst.Count(), bst.Iterate)
+}
+
+func (bst *bst[K, V]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[K, V](bst, data, codec.Default[K](), codec.Default[V]())
+}
+
+func (bst *bst[K, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[K, V](bst, data)
+}
+
+// load builds a balanced tree in O(n) when the keys come sorted, as they do when encoded by a BST.
+// Inserting them one by one would make the tree degenerate into a list.
+func (bst *bst[K, V]) load(keys []K, values []V) {
+	decoded := newBSTFromPairs(keys, values, bst.cmp, bst.nodes != nil)
+	decoded.modifications.Replaces(bst.modifications)
+	*bst = *decoded
+}
+
+func newBSTFromPairs[K comparable, V any](keys []K, values []V, cmp cmpFunc[K], withArena bool) *bst[K, V] {
+	tree := newBST[K, V](cmp, withArena)
+	if isSorted(keys, cmp) {
+		tree.root = tree.buildBalanced(keys, values)
+		tree.size = len(keys)
+	} else {
+		fill[K, V](tree, keys, values)
+	}
+	return tree
+}
+
+// ===================== Skip List ==========================
+
+func (list *skipList[K, V]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[K, V](list, codec.Default[K](), codec.Default[V]())
+}
+
+func (list *skipList[K, V]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalPairsJSON(list.Count(), list.Iterate)
+}
+
+func (list *skipList[K, V]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[K, V](list, data, codec.Default[K](), codec.Default[V]())
+}
+
+func (list *skipList[K, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[K, V](list, data)
+}
+
+func (list *skipList[K, V]) load(keys []K, values []V) {
+	decoded := CreateSkipListWithSeed[K, V](list.cmp, 0).(*skipList[K, V])
+	decoded.rng = list.rng
+	fill[K, V](decoded, keys, values)
+	decoded.modifications.Replaces(list.modifications)
+	*list = *decoded
+}
+
+// ===================== B-Tree ==========================
+
+func (tree *bTree[K, V]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[K, V](tree, codec.Default[K](), codec.Default[V]())
+}
+
+func (tree *bTree[K, V]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalPairsJSON(tree.Count(), tree.Iterate)
+}
+
+func (tree *bTree[K, V]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[K, V](tree, data, codec.Default[K](), codec.Default[V]())
+}
+
+func (tree *bTree[K, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[K, V](tree, data)
+}
+
+// load bulk loads the tree in O(n) when the keys come sorted, as they do when encoded by an ordered map.
+func (tree *bTree[K, V]) load(keys []K, values []V) {
+	var decoded *bTree[K, V]
+	if sorted, err := CreateBTreeFromSorted(keys, values, tree.cmp, tree.degree); err == nil {
+		decoded = sorted.(*bTree[K, V])
+	} else {
+		decoded = newBTree[K, V](tree.cmp, tree.degree)
+		fill[K, V](decoded, keys, values)
+	}
+	decoded.modifications.Replaces(tree.modifications)
+	*tree = *decoded
+}
+
+// ===================== Treap ==========================
+
+func (treap *treapMap[K, V]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[K, V](treap, codec.Default[K](), codec.Default[V]())
+}
+
+func (treap *treapMap[K, V]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalPairsJSON(treap.Count(), treap.Iterate)
+}
+
+func (treap *treapMap[K, V]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[K, V](treap, data, codec.Default[K](), codec.Default[V]())
+}
+
+func (treap *treapMap[K, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[K, V](treap, data)
+}
+
+func (treap *treapMap[K, V]) load(keys []K, values []V) {
+	decoded := CreateTreapWithSeed[K, V](treap.cmp, 0).(*treapMap[K, V])
+	decoded.rng = treap.rng
+	fill[K, V](decoded, keys, values)
+	decoded.modifications.Replaces(treap.modifications)
+	*treap = *decoded
+}
+
+// ===================== Aggregate Map ==========================
+
+func (tree *aggregateTree[K, V, A]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[K, V](tree, codec.Default[K](), codec.Default[V]())
+}
+
+func (tree *aggregateTree[K, V, A]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalPairsJSON(tree.Count(), tree.Iterate)
+}
+
+func (tree *aggregateTree[K, V, A]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[K, V](tree, data, codec.Default[K](), codec.Default[V]())
+}
+
+func (tree *aggregateTree[K, V, A]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[K, V](tree, data)
+}
+
+func (tree *aggregateTree[K, V, A]) load(keys []K, values []V) {
+	decoded := CreateAggregateMap(tree.cmp, tree.monoid).(*aggregateTree[K, V, A])
+	fill[K, V](decoded, keys, values)
+	decoded.modifications.Replaces(tree.modifications)
+	*tree = *decoded
+}
+
+// ===================== Radix Tree ==========================
+
+func (tree *radixTree[V]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[string, V](tree, codec.Default[string](), codec.Default[V]())
+}
+
+func (tree *radixTree[V]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalPairsJSON(tree.Count(), tree.Iterate)
+}
+
+func (tree *radixTree[V]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[string, V](tree, data, codec.Default[string](), codec.Default[V]())
+}
+
+func (tree *radixTree[V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[string, V](tree, data)
+}
+
+func (tree *radixTree[V]) load(keys []string, values []V) {
+	decoded := CreateRadixTree[V]().(*radixTree[V])
+	fill[string, V](decoded, keys, values)
+	decoded.modifications.Replaces(tree.modifications)
+	*tree = *decoded
+}
@@ -0,0 +1,139 @@
+package mymap_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireSameMap checks that both maps hold the same pairs and, if sameOrder, that they iterate them in
+// the same order. The order of a hash map depends on its table, which is not encoded.
+func requireSameMap(t *testing.T, expected, actual ADTMap.Map[int, int], sameOrder bool) {
+	require.EqualValues(t, expected.Count(), actual.Count())
+	expectedIter, actualIter := expected.Iterator(), actual.Iterator()
+	for expectedIter.HasNext() {
+		require.True(t, actualIter.HasNext())
+		expectedKey, expectedValue := expectedIter.Current()
+		require.Equal(t, expectedValue, actual.Get(expectedKey))
+		if sameOrder {
+			actualKey, _ := actualIter.Current()
+			require.Equal(t, expectedKey, actualKey)
+		}
+		expectedIter.Next()
+		actualIter.Next()
+	}
+	require.False(t, actualIter.HasNext())
+}
+
+func TestMapsEncoding(t *testing.T) {
+	factories := map[string]func() ADTMap.Map[int, int]{
+		"Hash":       ADTMap.NewHash[int, int],
+		"LinkedHash": ADTMap.NewLinkedHash[int, int],
+	}
+	for name, create := range orderedMapFactories() {
+		factories[name] = func() ADTMap.Map[int, int] { return create() }
+	}
+
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			dic := create()
+			for _, key := range rand.Perm(500) {
+				dic.Save(key, key*key)
+			}
+
+			binary, err := dic.(codec.Serializable).MarshalBinary()
+			require.NoError(t, err)
+			decoded := create()
+			decoded.Save(-1, -1)
+			require.NoError(t, decoded.(codec.Serializable).UnmarshalBinary(binary))
+			require.False(t, decoded.Contains(-1), "Unmarshalling should replace the contents of the map")
+			requireSameMap(t, dic, decoded, name != "Hash")
+
+			text, err := json.Marshal(dic)
+			require.NoError(t, err)
+			decoded = create()
+			require.NoError(t, json.Unmarshal(text, decoded))
+			requireSameMap(t, dic, decoded, name != "Hash")
+
+			require.Error(t, decoded.(codec.Serializable).UnmarshalBinary(binary[:len(binary)-1]))
+			requireSameMap(t, dic, decoded, name != "Hash")
+		})
+	}
+}
+
+func TestOrderedMapDecodingKeepsComparator(t *testing.T) {
+	ascending := ADTMap.CreateBST[int, int](cmpInt)
+	for _, key := range []int{3, 1, 2} {
+		ascending.Save(key, key)
+	}
+	binary, err := ascending.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+
+	descending := ADTMap.CreateBST[int, int](func(a, b int) int { return b - a })
+	require.NoError(t, descending.(codec.Serializable).UnmarshalBinary(binary))
+	keys := []int{}
+	descending.Iterate(func(key int, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, []int{3, 2, 1}, keys)
+}
+
+func TestRadixTreeEncoding(t *testing.T) {
+	tree := ADTMap.CreateRadixTree[[]string]()
+	tree.Save("/algoritmos", []string{"tp1", "tp2"})
+	tree.Save("/algebra", nil)
+
+	text, err := json.Marshal(tree)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"key":"/algebra","value":null},{"key":"/algoritmos","value":["tp1","tp2"]}]`, string(text))
+
+	decoded := ADTMap.CreateRadixTree[[]string]()
+	require.NoError(t, json.Unmarshal(text, decoded))
+	require.Equal(t, []string{"tp1", "tp2"}, decoded.Get("/algoritmos"))
+	require.EqualValues(t, 2, decoded.CountPrefix("/alg"))
+}
+
+// plainMap hides the methods of the map it wraps beyond the Map interface, as an implementation from outside
+// the package.
+type plainMap struct {
+	ADTMap.Map[int, int]
+}
+
+func TestMapsEncodingWithCodecs(t *testing.T) {
+	factories := map[string]func() ADTMap.Map[int, int]{
+		"BST":          func() ADTMap.Map[int, int] { return ADTMap.CreateBST[int, int](cmpInt) },
+		"Synchronized": func() ADTMap.Map[int, int] { return ADTMap.NewSynchronized(ADTMap.NewLinkedHash[int, int]()) },
+		"Plain":        func() ADTMap.Map[int, int] { return plainMap{ADTMap.NewLinkedHash[int, int]()} },
+	}
+	keys, values := codec.JSON[int](), codec.JSON[int]()
+
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			dic := create()
+			for key := 0; key < 200; key++ {
+				dic.Save(key, -key)
+			}
+
+			binary, err := ADTMap.MarshalWith(dic, keys, values)
+			require.NoError(t, err)
+			defaultBinary, err := ADTMap.MarshalWith(dic, codec.Default[int](), codec.Default[int]())
+			require.NoError(t, err)
+			require.NotEqual(t, defaultBinary, binary, "The given codecs should be used")
+
+			decoded := create()
+			decoded.Save(1000, 0)
+			require.NoError(t, ADTMap.UnmarshalWith(decoded, binary, keys, values))
+			require.False(t, decoded.Contains(1000), "Unmarshalling should replace the contents of the map")
+			requireSameMap(t, dic, decoded, true)
+
+			require.ErrorIs(t, ADTMap.UnmarshalWith(decoded, binary[:len(binary)-1], keys, values), codec.ErrTruncated)
+			requireSameMap(t, dic, decoded, true)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package mymap_test
+
+import (
+	"testing"
+
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapsTryMethods(t *testing.T) {
+	factories := map[string]func() ADTMap.Map[int, int]{
+		"Hash":       ADTMap.NewHash[int, int],
+		"LinkedHash": ADTMap.NewLinkedHash[int, int],
+		"Synchronized": func() ADTMap.Map[int, int] {
+			return ADTMap.NewSynchronized(ADTMap.NewHash[int, int]())
+		},
+		// A map without TryGet and TryRemove methods, which the functions fall back from
+		"Plain": func() ADTMap.Map[int, int] { return plainMap{ADTMap.NewHash[int, int]()} },
+	}
+	for name, create := range orderedMapFactories() {
+		factories[name] = func() ADTMap.Map[int, int] { return create() }
+	}
+
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			dic := create()
+			_, err := ADTMap.TryGet(dic, 1)
+			require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+			_, err = ADTMap.TryRemove(dic, 1)
+			require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+
+			dic.Save(1, 10)
+			value, err := ADTMap.TryGet(dic, 1)
+			require.NoError(t, err)
+			require.Equal(t, 10, value)
+			value, err = ADTMap.TryRemove(dic, 1)
+			require.NoError(t, err)
+			require.Equal(t, 10, value)
+			require.False(t, dic.Contains(1))
+
+			iter := dic.Iterator()
+			_, _, err = ADTMap.TryCurrent(iter)
+			require.ErrorIs(t, err, ADTMap.ErrIteratorExhausted)
+		})
+	}
+}
+
+func TestOtherMapsTryGet(t *testing.T) {
+	tree := ADTMap.CreateRadixTree[int]()
+	tree.Save("car", 1)
+	value, err := ADTMap.TryGet(tree, "car")
+	require.NoError(t, err)
+	require.Equal(t, 1, value)
+	_, err = ADTMap.TryGet(tree, "ca")
+	require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+	_, err = ADTMap.TryRemove(tree, "ca")
+	require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+
+	readers := map[string]ADTMap.MapReader[int, int]{
+		"PersistentHash": ADTMap.NewPersistentHash[int, int]().Save(1, 10),
+		"PersistentTree": ADTMap.CreatePersistentTree[int, int](cmpInt).Save(1, 10),
+	}
+	for name, reader := range readers {
+		t.Run(name, func(t *testing.T) {
+			value, err := ADTMap.TryGet(reader, 1)
+			require.NoError(t, err)
+			require.Equal(t, 10, value)
+			_, err = ADTMap.TryGet(reader, 2)
+			require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+		})
+	}
+}
@@ -3,15 +3,20 @@ package mymap
 import (
 	"fmt"
 	"hash/crc32"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
 )
 
 const (
-	_PANIC_HASH      = "The key does not belong to the map"
-	_PANIC_ITERATOR  = "The iterator has finished iterating"
-	_INITIAL_SIZE    = 17
-	_LOAD_FACTOR_INC = 0.7
-	_LOAD_FACTOR_DEC = _LOAD_FACTOR_INC / 4
-	_RESIZE_FACTOR   = 2
+	_PANIC_HASH              = "The key does not belong to the map"
+	_PANIC_ITERATOR          = "The iterator has finished iterating"
+	_CONCURRENT_MODIFICATION = "The map was modified while iterating"
+	_NEGATIVE_CAPACITY       = "The initial capacity cannot be negative"
+	_INVALID_LOAD_FACTORS    = "The load factors must satisfy 0 < grow < 1 and 0 < shrink < grow / 2"
+	_INITIAL_SIZE            = 17
+	_LOAD_FACTOR_INC         = 0.7
+	_LOAD_FACTOR_DEC_RATIO   = 4
+	_RESIZE_FACTOR           = 2
 )
 
 // ===================== Types ======================
@@ -31,15 +36,19 @@ type hashCell[K comparable, V any] struct {
 }
 
 type closedHash[K comparable, V any] struct {
-	table   []hashCell[K, V]
-	count   int
-	size    int
-	deleted int
+	table         []hashCell[K, V]
+	count         int
+	size          int
+	deleted       int
+	options       HashOptions
+	resizes       int
+	modifications modifications.Counter
 }
 
 type closedHashIterator[K comparable, V any] struct {
-	hash  *closedHash[K, V]
-	index int
+	hash          *closedHash[K, V]
+	index         int
+	modifications modifications.Check
 }
 
 // =================== Hash Function ===================
@@ -63,10 +72,12 @@ func (hash *closedHash[K, V]) getKeyHash(key K) int {
 }
 
 func (hash *closedHash[K, V]) resize(newSize int) {
-	if newSize < _INITIAL_SIZE {
-		newSize = _INITIAL_SIZE
+	if newSize < hash.options.InitialCapacity {
+		newSize = hash.options.InitialCapacity
 	}
 	newHash := new(closedHash[K, V])
+	newHash.options = hash.options
+	newHash.resizes = hash.resizes + 1
 	newHash.createTable(newSize)
 
 	for i := 0; i < hash.size; i++ {
@@ -74,6 +85,7 @@ func (hash *closedHash[K, V]) resize(newSize int) {
 			newHash.Save(hash.table[i].key, hash.table[i].value)
 		}
 	}
+	newHash.modifications = hash.modifications
 	*hash = *newHash
 }
 
@@ -95,6 +107,16 @@ func (hash *closedHash[K, V]) getPosition(key K, searchEmpty bool) int {
 	return -1
 }
 
+// loadFactor returns the fraction of the cells that are occupied or deleted, since both lengthen the probes.
+func (hash *closedHash[K, V]) loadFactor() float64 {
+	return float64(hash.count+hash.deleted) / float64(hash.size)
+}
+
+// probeLength returns the number of cells probed to find the key stored at the given position.
+func (hash *closedHash[K, V]) probeLength(position int) int {
+	return (position-hash.getKeyHash(hash.table[position].key)+hash.size)%hash.size + 1
+}
+
 func (hash *closedHash[K, V]) createTable(size int) {
 	hash.table = make([]hashCell[K, V], size)
 	hash.size = size
@@ -102,9 +124,22 @@ func (hash *closedHash[K, V]) createTable(size int) {
 
 // ================= Hash Primitives ==================
 
+// NewHash creates a map backed by a hash table with open addressing and linear probing, with the default
+// HashOptions. The map it returns is also a HashMap.
 func NewHash[K comparable, V any]() Map[K, V] {
+	return newClosedHash[K, V](HashOptions{})
+}
+
+// NewHashWithOptions creates a map like NewHash, whose table is sized and resized as the options say. It panics
+// if the initial capacity is negative or if the load factors are not valid.
+func NewHashWithOptions[K comparable, V any](options HashOptions) HashMap[K, V] {
+	return newClosedHash[K, V](options)
+}
+
+func newClosedHash[K comparable, V any](options HashOptions) *closedHash[K, V] {
 	hash := new(closedHash[K, V])
-	hash.createTable(_INITIAL_SIZE)
+	hash.options = options.withDefaults()
+	hash.createTable(hash.options.InitialCapacity)
 	return hash
 }
 
@@ -118,10 +153,11 @@ func (hash *closedHash[K, V]) Save(key K, value V) {
 
 	if hash.table[keyHash].state == EMPTY {
 		hash.count++
+		hash.modifications.Modified()
 	}
 	hash.table[keyHash] = cell
 
-	if loadFactor := float64(hash.count+hash.deleted) / float64(hash.size); loadFactor >= _LOAD_FACTOR_INC {
+	if hash.loadFactor() >= hash.options.GrowLoadFactor {
 		hash.resize(hash.size * _RESIZE_FACTOR)
 	}
 }
@@ -137,13 +173,26 @@ func (hash *closedHash[K, V]) Get(key K) V {
 	panic(_PANIC_HASH)
 }
 
+func (hash *closedHash[K, V]) TryGet(key K) (V, error) {
+	pos := hash.getPosition(key, false)
+	if pos == -1 {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return hash.table[pos].value, nil
+}
+
 func (hash *closedHash[K, V]) Remove(key K) V {
 	if pos := hash.getPosition(key, false); pos != -1 {
 		value := hash.table[pos].value
 		hash.table[pos].state = DELETED
 		hash.count--
 		hash.deleted++
-		if loadFactor := float64(hash.count+hash.deleted) / float64(hash.size); loadFactor <= _LOAD_FACTOR_DEC {
+		hash.modifications.Modified()
+		// Deleted cells are not counted here, otherwise removing keys could never make the table shrink.
+		// A table at its initial capacity is left as it is, since it cannot get any smaller.
+		if float64(hash.count)/float64(hash.size) <= hash.options.ShrinkLoadFactor &&
+			hash.size/_RESIZE_FACTOR >= hash.options.InitialCapacity {
 			hash.resize(hash.size / _RESIZE_FACTOR)
 		}
 		return value
@@ -178,11 +227,13 @@ func (it *closedHashIterator[K, V]) nextOccupied() {
 func (hash *closedHash[K, V]) Iterator() MapIterator[K, V] {
 	it := new(closedHashIterator[K, V])
 	it.hash = hash
+	it.modifications = modifications.NewCheck(&hash.modifications, _CONCURRENT_MODIFICATION)
 	it.nextOccupied()
 	return it
 }
 
 func (it *closedHashIterator[K, V]) HasNext() bool {
+	it.modifications.Verify()
 	for it.index < it.hash.size {
 		if it.hash.table[it.index].state == OCCUPIED {
 			return true
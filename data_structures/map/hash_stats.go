@@ -0,0 +1,92 @@
+package mymap
+
+// HashMap is a Map backed by a hash table, which reports how full the table is and how long its probes are.
+type HashMap[K comparable, V any] interface {
+	Map[K, V]
+
+	// Stats returns the statistics of the table. It traverses the whole table, so it takes linear time
+	Stats() HashStats
+}
+
+// HashOptions configures the table of a hash map. The zero value of each field selects its default.
+type HashOptions struct {
+	// InitialCapacity is the number of cells the table starts with, and below which it never shrinks.
+	// Defaults to 17
+	InitialCapacity int
+
+	// GrowLoadFactor is the fraction of occupied and deleted cells at which the table doubles its size.
+	// It must be lower than 1, since probing needs empty cells. Defaults to 0.7
+	GrowLoadFactor float64
+
+	// ShrinkLoadFactor is the fraction of occupied cells at which the table halves its size after a removal,
+	// which also clears the deleted cells. It must be lower than half of GrowLoadFactor, so that a table does
+	// not grow right after shrinking. Defaults to a quarter of GrowLoadFactor
+	ShrinkLoadFactor float64
+}
+
+// HashStats describes the state of the table of a hash map.
+type HashStats struct {
+	// Capacity is the number of cells of the table
+	Capacity int
+
+	// Count is the number of keys stored
+	Count int
+
+	// Deleted is the number of cells left by removed keys, which are still probed until the table is resized
+	Deleted int
+
+	// LoadFactor is the fraction of occupied and deleted cells
+	LoadFactor float64
+
+	// AverageProbeLength is the average number of cells probed to find a stored key, 1 if it is at the cell
+	// its hash points to
+	AverageProbeLength float64
+
+	// MaxProbeLength is the largest number of cells probed to find a stored key
+	MaxProbeLength int
+
+	// Resizes is the number of times the table grew or shrank since the map was created
+	Resizes int
+}
+
+func (options HashOptions) withDefaults() HashOptions {
+	if options.InitialCapacity < 0 {
+		panic(_NEGATIVE_CAPACITY)
+	}
+	if options.InitialCapacity == 0 {
+		options.InitialCapacity = _INITIAL_SIZE
+	}
+	if options.GrowLoadFactor == 0 {
+		options.GrowLoadFactor = _LOAD_FACTOR_INC
+	}
+	if options.ShrinkLoadFactor == 0 {
+		options.ShrinkLoadFactor = options.GrowLoadFactor / _LOAD_FACTOR_DEC_RATIO
+	}
+	if options.GrowLoadFactor <= 0 || options.GrowLoadFactor >= 1 ||
+		options.ShrinkLoadFactor <= 0 || options.ShrinkLoadFactor >= options.GrowLoadFactor/_RESIZE_FACTOR {
+		panic(_INVALID_LOAD_FACTORS)
+	}
+	return options
+}
+
+func (hash *closedHash[K, V]) Stats() HashStats {
+	stats := HashStats{
+		Capacity:   hash.size,
+		Count:      hash.count,
+		Deleted:    hash.deleted,
+		LoadFactor: hash.loadFactor(),
+		Resizes:    hash.resizes,
+	}
+	totalProbes := 0
+	for i, cell := range hash.table {
+		if cell.state == OCCUPIED {
+			probes := hash.probeLength(i)
+			totalProbes += probes
+			stats.MaxProbeLength = max(stats.MaxProbeLength, probes)
+		}
+	}
+	if hash.count > 0 {
+		stats.AverageProbeLength = float64(totalProbes) / float64(hash.count)
+	}
+	return stats
+}
@@ -0,0 +1,92 @@
+package mymap_test
+
+import (
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+	"github.com/sebagarciad/algorithms-and-data-structures/map/maptest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashStats(t *testing.T) {
+	dic, ok := ADTMap.NewHash[int, int]().(ADTMap.HashMap[int, int])
+	require.True(t, ok, "NewHash should return a HashMap")
+	stats := dic.Stats()
+	require.Equal(t, ADTMap.HashStats{Capacity: 17}, stats)
+
+	for i := 0; i < 100; i++ {
+		dic.Save(i, i)
+	}
+	stats = dic.Stats()
+	require.Equal(t, 100, stats.Count)
+	require.Equal(t, 0, stats.Deleted)
+	require.Greater(t, stats.Resizes, 0)
+	require.Less(t, stats.LoadFactor, 0.7)
+	require.InDelta(t, float64(stats.Count)/float64(stats.Capacity), stats.LoadFactor, 1e-9)
+	require.GreaterOrEqual(t, stats.AverageProbeLength, 1.0)
+	require.GreaterOrEqual(t, float64(stats.MaxProbeLength), stats.AverageProbeLength)
+
+	resizes := stats.Resizes
+	dic.Remove(0)
+	dic.Remove(1)
+	stats = dic.Stats()
+	require.Equal(t, 98, stats.Count)
+	require.Equal(t, 2, stats.Deleted, "Removed keys should leave deleted cells")
+	require.Equal(t, resizes, stats.Resizes)
+}
+
+func TestHashOptions(t *testing.T) {
+	dic := ADTMap.NewHashWithOptions[int, int](ADTMap.HashOptions{InitialCapacity: 1000, GrowLoadFactor: 0.5})
+	for i := 0; i < 499; i++ {
+		dic.Save(i, i)
+	}
+	stats := dic.Stats()
+	require.Equal(t, 1000, stats.Capacity)
+	require.Equal(t, 0, stats.Resizes, "The table should not grow before reaching the load factor")
+
+	dic.Save(499, 499)
+	require.Equal(t, 2000, dic.Stats().Capacity)
+	for i := 0; i < 500; i++ {
+		dic.Remove(i)
+		require.NoError(t, debug.Validate(dic))
+	}
+	require.Equal(t, 1000, dic.Stats().Capacity, "The table should not shrink below its initial capacity")
+
+	require.PanicsWithValue(t, "The initial capacity cannot be negative", func() {
+		ADTMap.NewHashWithOptions[int, int](ADTMap.HashOptions{InitialCapacity: -1})
+	})
+	for _, options := range []ADTMap.HashOptions{
+		{GrowLoadFactor: 1},
+		{GrowLoadFactor: -0.5},
+		{GrowLoadFactor: 0.5, ShrinkLoadFactor: 0.25},
+		{ShrinkLoadFactor: -0.1},
+	} {
+		require.PanicsWithValue(t, "The load factors must satisfy 0 < grow < 1 and 0 < shrink < grow / 2",
+			func() { ADTMap.NewHashWithOptions[int, int](options) })
+	}
+}
+
+func TestHashDoesNotResizeAtInitialCapacity(t *testing.T) {
+	dic := ADTMap.NewHashWithOptions[int, int](ADTMap.HashOptions{InitialCapacity: 1000})
+	for i := 0; i < 100; i++ {
+		dic.Save(i, i)
+	}
+	for i := 0; i < 100; i++ {
+		dic.Remove(i)
+	}
+	stats := dic.Stats()
+	require.Equal(t, 1000, stats.Capacity)
+	require.Equal(t, 0, stats.Resizes, "Removing from a table at its initial capacity should not rebuild it")
+}
+
+func TestHashWithOptionsConformance(t *testing.T) {
+	maptest.RunConformance(t, func() ADTMap.Map[int, int] {
+		return ADTMap.NewHashWithOptions[int, int](ADTMap.HashOptions{
+			InitialCapacity:  1,
+			GrowLoadFactor:   0.9,
+			ShrinkLoadFactor: 0.1,
+		})
+	})
+}
@@ -0,0 +1,89 @@
+package mymap
+
+import (
+	"fmt"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+)
+
+// The binary trees of this package implement debug.Inspector by copying their nodes, labelled with their
+// key and value, into a debug.TreeNode tree.
+
+func nodeLabel[K comparable, V any](key K, value V) string {
+	return fmt.Sprintf("%v: %v", key, value)
+}
+
+// ===================== bst ==========================
+
+func (bst *bst[K, V]) DOT() string {
+	return debug.DOT(bst.inspect(bst.root))
+}
+
+func (bst *bst[K, V]) ASCII() string {
+	return debug.ASCII(bst.inspect(bst.root))
+}
+
+func (bst *bst[K, V]) TreeStats() debug.TreeStats {
+	return debug.Stats(bst.inspect(bst.root))
+}
+
+func (bst *bst[K, V]) inspect(node *bstNode[K, V]) *debug.TreeNode {
+	if node == nil {
+		return nil
+	}
+	return &debug.TreeNode{
+		Label: nodeLabel(node.key, node.value),
+		Left:  bst.inspect(node.left),
+		Right: bst.inspect(node.right),
+	}
+}
+
+// ===================== treapMap ==========================
+
+func (treap *treapMap[K, V]) DOT() string {
+	return debug.DOT(treap.inspect(treap.root))
+}
+
+func (treap *treapMap[K, V]) ASCII() string {
+	return debug.ASCII(treap.inspect(treap.root))
+}
+
+func (treap *treapMap[K, V]) TreeStats() debug.TreeStats {
+	return debug.Stats(treap.inspect(treap.root))
+}
+
+func (treap *treapMap[K, V]) inspect(node *treapNode[K, V]) *debug.TreeNode {
+	if node == nil {
+		return nil
+	}
+	return &debug.TreeNode{
+		Label: nodeLabel(node.key, node.value),
+		Left:  treap.inspect(node.left),
+		Right: treap.inspect(node.right),
+	}
+}
+
+// ===================== aggregateTree ==========================
+
+func (tree *aggregateTree[K, V, A]) DOT() string {
+	return debug.DOT(tree.inspect(tree.root))
+}
+
+func (tree *aggregateTree[K, V, A]) ASCII() string {
+	return debug.ASCII(tree.inspect(tree.root))
+}
+
+func (tree *aggregateTree[K, V, A]) TreeStats() debug.TreeStats {
+	return debug.Stats(tree.inspect(tree.root))
+}
+
+func (tree *aggregateTree[K, V, A]) inspect(node *aggregateNode[K, V, A]) *debug.TreeNode {
+	if node == nil {
+		return nil
+	}
+	return &debug.TreeNode{
+		Label: nodeLabel(node.key, node.value),
+		Left:  tree.inspect(node.left),
+		Right: tree.inspect(node.right),
+	}
+}
@@ -0,0 +1,56 @@
+package mymap_test
+
+import (
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBSTInspector(t *testing.T) {
+	dic := ADTMap.CreateBST[int, string](cmpInt)
+	for _, key := range []int{2, 1, 4, 3} {
+		dic.Save(key, string(rune('a'+key)))
+	}
+	inspector, ok := dic.(debug.Inspector)
+	require.True(t, ok)
+
+	require.Equal(t, "2: c\n"+
+		"|-- 1: b\n"+
+		"`-- 4: e\n"+
+		"    |-- 3: d\n"+
+		"    `-- nil\n", inspector.ASCII())
+	require.Contains(t, inspector.DOT(), `n3 [label="3: d"];`)
+	require.Equal(t, debug.TreeStats{Nodes: 4, Height: 3, Leaves: 2, AverageDepth: 1, MaxImbalance: 1},
+		inspector.TreeStats())
+
+	sorted := ADTMap.CreateBST[int, string](cmpInt)
+	for i := 0; i < 100; i++ {
+		sorted.Save(i, "")
+	}
+	stats := sorted.(debug.Inspector).TreeStats()
+	require.Equal(t, 100, stats.Height, "Inserting sorted keys should make the tree degenerate")
+	require.Equal(t, 99, stats.MaxImbalance)
+}
+
+func TestBalancedTreesInspector(t *testing.T) {
+	factories := map[string]func() ADTMap.BSTMap[int, int]{
+		"Treap": func() ADTMap.BSTMap[int, int] { return ADTMap.CreateTreapWithSeed[int, int](cmpInt, 1) },
+		"Aggregate": func() ADTMap.BSTMap[int, int] {
+			return ADTMap.CreateAggregateMap(cmpInt, ADTMap.CountMonoid[int, int]())
+		},
+	}
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			dic := create()
+			for i := 0; i < 1000; i++ {
+				dic.Save(i, i)
+			}
+			stats := dic.(debug.Inspector).TreeStats()
+			require.Equal(t, 1000, stats.Nodes)
+			require.Less(t, stats.Height, 40, "Sorted keys should not unbalance the tree")
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package mymap
+
+import "github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+
+// ===================== Types ======================
+
+// linkedEntry is an entry of a linkedHash. Besides the key and value, it holds the links of the list of
+// entries, so that no separate list node is needed.
+type linkedEntry[K comparable, V any] struct {
+	key      K
+	value    V
+	previous *linkedEntry[K, V]
+	next     *linkedEntry[K, V]
+}
+
+// linkedHash is a Map that indexes its entries with a hash map and keeps them in a circular doubly linked
+// list, from the oldest to the newest. sentinel marks both ends of the list and holds no entry. In access
+// order mode, reading or updating an entry makes it the newest one.
+type linkedHash[K comparable, V any] struct {
+	entries       Map[K, *linkedEntry[K, V]]
+	sentinel      *linkedEntry[K, V]
+	accessOrder   bool
+	modifications modifications.Counter
+}
+
+type linkedHashIterator[K comparable, V any] struct {
+	current       *linkedEntry[K, V]
+	sentinel      *linkedEntry[K, V]
+	modifications modifications.Check
+}
+
+// ============= Linked Hash Auxiliaries ==============
+
+func (hash *linkedHash[K, V]) unlink(entry *linkedEntry[K, V]) {
+	entry.previous.next = entry.next
+	entry.next.previous = entry.previous
+}
+
+// linkLast makes the entry the newest one.
+func (hash *linkedHash[K, V]) linkLast(entry *linkedEntry[K, V]) {
+	entry.previous = hash.sentinel.previous
+	entry.next = hash.sentinel
+	hash.sentinel.previous.next = entry
+	hash.sentinel.previous = entry
+}
+
+func (hash *linkedHash[K, V]) touch(entry *linkedEntry[K, V]) {
+	if hash.accessOrder {
+		hash.unlink(entry)
+		hash.linkLast(entry)
+		hash.modifications.Modified()
+	}
+}
+
+func newLinkedHash[K comparable, V any](accessOrder bool) *linkedHash[K, V] {
+	hash := new(linkedHash[K, V])
+	hash.entries = NewHash[K, *linkedEntry[K, V]]()
+	hash.sentinel = new(linkedEntry[K, V])
+	hash.sentinel.previous = hash.sentinel
+	hash.sentinel.next = hash.sentinel
+	hash.accessOrder = accessOrder
+	return hash
+}
+
+// ================= Linked Hash Primitives ==================
+
+// NewLinkedHash creates a hash map that iterates its keys in the order they were first saved.
+// Saving an existing key again does not change its position.
+func NewLinkedHash[K comparable, V any]() Map[K, V] {
+	return newLinkedHash[K, V](false)
+}
+
+// NewLinkedHashAccessOrder creates a hash map that iterates its keys from the least to the most recently
+// accessed, where both Save and Get count as accesses.
+func NewLinkedHashAccessOrder[K comparable, V any]() Map[K, V] {
+	return newLinkedHash[K, V](true)
+}
+
+func (hash *linkedHash[K, V]) Save(key K, value V) {
+	if hash.entries.Contains(key) {
+		entry := hash.entries.Get(key)
+		entry.value = value
+		hash.touch(entry)
+		return
+	}
+	entry := &linkedEntry[K, V]{key: key, value: value}
+	hash.linkLast(entry)
+	hash.entries.Save(key, entry)
+	hash.modifications.Modified()
+}
+
+func (hash *linkedHash[K, V]) Contains(key K) bool {
+	return hash.entries.Contains(key)
+}
+
+func (hash *linkedHash[K, V]) Get(key K) V {
+	if !hash.entries.Contains(key) {
+		panic(_PANIC_HASH)
+	}
+	entry := hash.entries.Get(key)
+	hash.touch(entry)
+	return entry.value
+}
+
+func (hash *linkedHash[K, V]) TryGet(key K) (V, error) {
+	entry, err := TryGet(hash.entries, key)
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	hash.touch(entry)
+	return entry.value, nil
+}
+
+func (hash *linkedHash[K, V]) Remove(key K) V {
+	if !hash.entries.Contains(key) {
+		panic(_PANIC_HASH)
+	}
+	entry := hash.entries.Remove(key)
+	hash.unlink(entry)
+	hash.modifications.Modified()
+	return entry.value
+}
+
+func (hash *linkedHash[K, V]) Count() int {
+	return hash.entries.Count()
+}
+
+// =================== Internal Iterator ===================
+
+func (hash *linkedHash[K, V]) Iterate(visit func(key K, value V) bool) {
+	for entry := hash.sentinel.next; entry != hash.sentinel; entry = entry.next {
+		if !visit(entry.key, entry.value) {
+			return
+		}
+	}
+}
+
+// =================== External Iterator ===================
+
+func (hash *linkedHash[K, V]) Iterator() MapIterator[K, V] {
+	it := new(linkedHashIterator[K, V])
+	it.current = hash.sentinel.next
+	it.sentinel = hash.sentinel
+	it.modifications = modifications.NewCheck(&hash.modifications, _CONCURRENT_MODIFICATION)
+	return it
+}
+
+func (it *linkedHashIterator[K, V]) HasNext() bool {
+	it.modifications.Verify()
+	return it.current != it.sentinel
+}
+
+func (it *linkedHashIterator[K, V]) Current() (K, V) {
+	if !it.HasNext() {
+		panic(_PANIC_ITERATOR)
+	}
+	return it.current.key, it.current.value
+}
+
+func (it *linkedHashIterator[K, V]) Next() {
+	if !it.HasNext() {
+		panic(_PANIC_ITERATOR)
+	}
+	it.current = it.current.next
+}
@@ -0,0 +1,96 @@
+package mymap_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+func iteratedKeys[K comparable, V any](dic ADTMap.Map[K, V]) []K {
+	keys := []K{}
+	for iter := dic.Iterator(); iter.HasNext(); iter.Next() {
+		key, _ := iter.Current()
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestLinkedHashEmpty(t *testing.T) {
+	dic := ADTMap.NewLinkedHash[string, int]()
+	require.EqualValues(t, 0, dic.Count())
+	require.False(t, dic.Contains(""))
+	require.PanicsWithValue(t, "The key does not belong to the map", func() { dic.Get("A") })
+	require.PanicsWithValue(t, "The key does not belong to the map", func() { dic.Remove("A") })
+	iter := dic.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Current() })
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Next() })
+}
+
+func TestLinkedHashInsertionOrder(t *testing.T) {
+	dic := ADTMap.NewLinkedHash[string, int]()
+	dic.Save("c", 1)
+	dic.Save("a", 2)
+	dic.Save("b", 3)
+	dic.Save("a", 20)
+	dic.Get("c")
+	require.Equal(t, []string{"c", "a", "b"}, iteratedKeys(dic), "Updating or reading a key should keep its position")
+	require.Equal(t, 20, dic.Get("a"))
+
+	require.Equal(t, 20, dic.Remove("a"))
+	dic.Save("a", 4)
+	require.Equal(t, []string{"c", "b", "a"}, iteratedKeys(dic), "A removed key should be inserted again at the end")
+
+	values := []int{}
+	dic.Iterate(func(_ string, value int) bool {
+		values = append(values, value)
+		return len(values) < 2
+	})
+	require.Equal(t, []int{1, 3}, values)
+}
+
+func TestLinkedHashAccessOrder(t *testing.T) {
+	dic := ADTMap.NewLinkedHashAccessOrder[string, int]()
+	dic.Save("a", 1)
+	dic.Save("b", 2)
+	dic.Save("c", 3)
+	dic.Get("a")
+	require.Equal(t, []string{"b", "c", "a"}, iteratedKeys(dic))
+	dic.Save("b", 20)
+	require.Equal(t, []string{"c", "a", "b"}, iteratedKeys(dic))
+	require.True(t, dic.Contains("c"))
+	require.Equal(t, []string{"c", "a", "b"}, iteratedKeys(dic), "Contains should not count as an access")
+}
+
+func TestLinkedHashVolume(t *testing.T) {
+	rng := rand.New(rand.NewSource(17))
+	dic := ADTMap.NewLinkedHash[string, int]()
+	order := []string{}
+	stored := make(map[string]int)
+
+	for i := 0; i < 20000; i++ {
+		key := fmt.Sprintf("%d", rng.Intn(2000))
+		if _, ok := stored[key]; ok && rng.Intn(2) == 0 {
+			require.Equal(t, stored[key], dic.Remove(key))
+			delete(stored, key)
+			for j, current := range order {
+				if current == key {
+					order = append(order[:j], order[j+1:]...)
+					break
+				}
+			}
+		} else {
+			if _, ok := stored[key]; !ok {
+				order = append(order, key)
+			}
+			dic.Save(key, i)
+			stored[key] = i
+		}
+	}
+	require.EqualValues(t, len(stored), dic.Count())
+	require.Equal(t, order, iteratedKeys(dic), "The keys should be iterated in insertion order")
+}
@@ -1,5 +1,26 @@
 package mymap
 
+import "errors"
+
+var (
+	// ErrKeyNotFound is returned by TryGet and TryRemove when the key does not belong to the map.
+	ErrKeyNotFound = errors.New("mymap: key not found")
+
+	// ErrIteratorExhausted is returned by TryCurrent when the iterator has finished iterating.
+	ErrIteratorExhausted = errors.New("mymap: iterator exhausted")
+
+	// ErrUnknownComparator is returned by MergeBST when one of the maps does not expose its comparator, because
+	// it was not created by this package.
+	ErrUnknownComparator = errors.New("mymap: unknown comparator")
+
+	// ErrComparatorMismatch is returned by MergeBST when the maps are not ordered by the same comparator.
+	ErrComparatorMismatch = errors.New("mymap: maps ordered by different comparators")
+
+	// ErrNotSorted is returned by BSTFromSorted and CreateBTreeFromSorted when the keys are not sorted in
+	// strictly increasing order.
+	ErrNotSorted = errors.New("mymap: keys not sorted")
+)
+
 type Map[K comparable, V any] interface {
 	// Save stores the key-value pair in the Map. If the key is already present in the Map,
 	// the associated value is updated
@@ -26,6 +47,9 @@ type Map[K comparable, V any] interface {
 	Iterator() MapIterator[K, V]
 }
 
+// MapIterator is invalidated when its map is modified by adding or removing keys, or by reordering them, other
+// than through the iterator itself. From then on, any of its methods panics with the message
+// 'The map was modified while iterating'. Updating the value of an existing key is always allowed.
 type MapIterator[K comparable, V any] interface {
 	// HasNext returns true if there are more elements to see, that is, if the iterator is at an element.
 	// Otherwise, returns false
@@ -39,3 +63,52 @@ type MapIterator[K comparable, V any] interface {
 	// 'The iterator has finished iterating'
 	Next()
 }
+
+// TryCurrent returns the key and value of the element where the iterator is, or ErrIteratorExhausted if it
+// has finished iterating.
+func TryCurrent[K comparable, V any](iterator MapIterator[K, V]) (K, V, error) {
+	if !iterator.HasNext() {
+		var (
+			zeroKey   K
+			zeroValue V
+		)
+		return zeroKey, zeroValue, ErrIteratorExhausted
+	}
+	key, value := iterator.Current()
+	return key, value, nil
+}
+
+// tryGetter and tryRemover are implemented by the maps that look the key up only once for TryGet and TryRemove,
+// and by the synchronized ones, which must look it up and get or remove it atomically.
+type tryGetter[K comparable, V any] interface {
+	TryGet(key K) (V, error)
+}
+
+type tryRemover[K comparable, V any] interface {
+	TryRemove(key K) (V, error)
+}
+
+// TryGet returns the value associated with the key, or ErrKeyNotFound if the key does not belong to the map.
+func TryGet[K comparable, V any](dic MapReader[K, V], key K) (V, error) {
+	if dic, ok := dic.(tryGetter[K, V]); ok {
+		return dic.TryGet(key)
+	}
+	if !dic.Contains(key) {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return dic.Get(key), nil
+}
+
+// TryRemove removes the key from the map and returns the associated value, or ErrKeyNotFound if the key does
+// not belong to the map.
+func TryRemove[K comparable, V any](dic Map[K, V], key K) (V, error) {
+	if dic, ok := dic.(tryRemover[K, V]); ok {
+		return dic.TryRemove(key)
+	}
+	if !dic.Contains(key) {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return dic.Remove(key), nil
+}
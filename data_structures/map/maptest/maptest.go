@@ -0,0 +1,431 @@
+// Package maptest verifies that implementations of mymap.Map and mymap.BSTMap behave as the package documents,
+// so that a new implementation can be checked with a single call to RunConformance or RunOrderedConformance
+// from its tests.
+package maptest
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_ITERATOR_FINISH         = "The iterator has finished iterating"
+	_CONCURRENT_MODIFICATION = "The map was modified while iterating"
+	_VOLUME                  = 10000
+	_RANDOM_OPS              = 5000
+	_RANDOM_KEYS             = 300
+	_DELETE_KEYS             = 2000
+)
+
+func cmpInt(a, b int) int {
+	return a - b
+}
+
+func cmpReverse(a, b int) int {
+	return b - a
+}
+
+// RunConformance runs the behavioural, volume, encoding and randomized tests against the maps created by
+// factory, each of which must be empty. The order of iteration is not checked.
+// The encoding tests are skipped for the maps that do not implement codec.Serializable.
+func RunConformance(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, factory) })
+	t.Run("SaveAndGet", func(t *testing.T) { testSaveAndGet(t, factory) })
+	t.Run("Remove", func(t *testing.T) { testRemove(t, factory) })
+	t.Run("Iterate", func(t *testing.T) { testIterate(t, factory) })
+	t.Run("Iterator", func(t *testing.T) { testIterator(t, factory) })
+	t.Run("IteratorFailsFast", func(t *testing.T) { testIteratorFailsFast(t, factory) })
+	t.Run("Volume", func(t *testing.T) { testVolume(t, factory) })
+	t.Run("Encoding", func(t *testing.T) { testEncoding(t, factory) })
+	t.Run("Randomized", func(t *testing.T) { testRandomized(t, factory) })
+}
+
+// RunOrderedConformance runs RunConformance and then the tests of ordered iteration, ranges, Seek and Delete
+// against the maps created by factory, each of which must be empty and sort its keys with the given comparator.
+func RunOrderedConformance(t *testing.T, factory func(cmp func(a, b int) int) ADTMap.BSTMap[int, int]) {
+	RunConformance(t, func() ADTMap.Map[int, int] { return factory(cmpInt) })
+	t.Run("Ordered", func(t *testing.T) { testOrdered(t, factory) })
+	t.Run("Ranges", func(t *testing.T) { testRanges(t, factory) })
+	t.Run("Seek", func(t *testing.T) { testSeek(t, factory) })
+	t.Run("IteratorDelete", func(t *testing.T) { testIteratorDelete(t, factory) })
+	t.Run("RandomizedRanges", func(t *testing.T) { testRandomizedRanges(t, factory) })
+}
+
+// ===================== Helpers ==========================
+
+// contents returns the pairs of the map, checking with the external iterator that it visits the same ones
+// as the internal one, in the same order, and as many as Count.
+func contents(t *testing.T, dic ADTMap.Map[int, int]) ([]int, map[int]int) {
+	keys, pairs := []int{}, make(map[int]int)
+	dic.Iterate(func(key, value int) bool {
+		keys = append(keys, key)
+		pairs[key] = value
+		return true
+	})
+	require.Len(t, pairs, len(keys), "No key should be visited twice")
+	require.EqualValues(t, len(keys), dic.Count())
+
+	iter := dic.Iterator()
+	for _, key := range keys {
+		require.True(t, iter.HasNext())
+		current, value := iter.Current()
+		require.Equal(t, key, current)
+		require.Equal(t, pairs[key], value)
+		iter.Next()
+	}
+	require.False(t, iter.HasNext())
+	return keys, pairs
+}
+
+func requireSameContents(t *testing.T, expected map[int]int, dic ADTMap.Map[int, int]) {
+	_, pairs := contents(t, dic)
+	require.Equal(t, expected, pairs)
+}
+
+func fromPairs[M ADTMap.Map[int, int]](dic M, keys []int) M {
+	for _, key := range keys {
+		dic.Save(key, key*10)
+	}
+	return dic
+}
+
+// ===================== Map ==========================
+
+func testEmpty(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	dic := factory()
+	require.EqualValues(t, 0, dic.Count())
+	require.False(t, dic.Contains(0), "The zero value is not a key of an empty map")
+	require.Panics(t, func() { dic.Get(0) })
+	require.Panics(t, func() { dic.Remove(0) })
+	_, err := ADTMap.TryGet(dic, 0)
+	require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+	_, err = ADTMap.TryRemove(dic, 0)
+	require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+
+	iter := dic.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, _ITERATOR_FINISH, func() { iter.Current() })
+	require.PanicsWithValue(t, _ITERATOR_FINISH, func() { iter.Next() })
+	_, _, err = ADTMap.TryCurrent(iter)
+	require.ErrorIs(t, err, ADTMap.ErrIteratorExhausted)
+}
+
+func testSaveAndGet(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	dic := factory()
+	for i, key := range []int{5, -3, 0, 12} {
+		dic.Save(key, key*10)
+		require.EqualValues(t, i+1, dic.Count())
+		require.True(t, dic.Contains(key))
+		require.Equal(t, key*10, dic.Get(key))
+	}
+	require.False(t, dic.Contains(1))
+	require.Panics(t, func() { dic.Get(1) })
+
+	dic.Save(5, 7)
+	require.EqualValues(t, 4, dic.Count(), "Saving an existing key should only update its value")
+	value, err := ADTMap.TryGet(dic, 5)
+	require.NoError(t, err)
+	require.Equal(t, 7, value)
+	requireSameContents(t, map[int]int{5: 7, -3: -30, 0: 0, 12: 120}, dic)
+}
+
+func testRemove(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	dic := fromPairs(factory(), []int{1, 2, 3, 4})
+	require.Equal(t, 20, dic.Remove(2))
+	require.False(t, dic.Contains(2))
+	require.Panics(t, func() { dic.Remove(2) })
+	value, err := ADTMap.TryRemove(dic, 4)
+	require.NoError(t, err)
+	require.Equal(t, 40, value)
+	_, err = ADTMap.TryRemove(dic, 4)
+	require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+	requireSameContents(t, map[int]int{1: 10, 3: 30}, dic)
+
+	dic.Save(2, 5)
+	require.Equal(t, 5, dic.Get(2), "A removed key can be saved again")
+	dic.Remove(1)
+	dic.Remove(2)
+	dic.Remove(3)
+	requireSameContents(t, map[int]int{}, dic)
+}
+
+func testIterate(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	dic := fromPairs(factory(), []int{1, 2, 3, 4, 5})
+	visited := 0
+	dic.Iterate(func(key, value int) bool {
+		require.Equal(t, key*10, value)
+		visited++
+		return visited < 3
+	})
+	require.Equal(t, 3, visited, "The iteration should stop when visit returns false")
+
+	factory().Iterate(func(int, int) bool {
+		require.Fail(t, "An empty map has nothing to visit")
+		return true
+	})
+}
+
+func testIterator(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	dic := fromPairs(factory(), []int{1, 2, 3})
+	iter := dic.Iterator()
+	seen := make(map[int]int)
+	for iter.HasNext() {
+		key, value, err := ADTMap.TryCurrent(iter)
+		require.NoError(t, err)
+		seen[key] = value
+		iter.Next()
+	}
+	require.Equal(t, map[int]int{1: 10, 2: 20, 3: 30}, seen)
+	require.PanicsWithValue(t, _ITERATOR_FINISH, func() { iter.Current() })
+	require.PanicsWithValue(t, _ITERATOR_FINISH, func() { iter.Next() })
+}
+
+func testIteratorFailsFast(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	dic := fromPairs(factory(), []int{1, 2, 3})
+	iter := dic.Iterator()
+	dic.Save(1, 100)
+	require.True(t, iter.HasNext(), "Updating a value should not invalidate the iterator")
+
+	dic.Save(4, 40)
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.HasNext() })
+
+	iter = dic.Iterator()
+	dic.Remove(4)
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.Current() })
+}
+
+func testVolume(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	dic := factory()
+	keys := rand.New(rand.NewSource(1)).Perm(_VOLUME)
+	fromPairs(dic, keys)
+	require.EqualValues(t, _VOLUME, dic.Count())
+	for _, key := range keys {
+		require.Equal(t, key*10, dic.Get(key))
+	}
+	for _, key := range keys[:_VOLUME/2] {
+		require.Equal(t, key*10, dic.Remove(key))
+	}
+	require.EqualValues(t, _VOLUME/2, dic.Count())
+	for i, key := range keys {
+		require.Equal(t, i >= _VOLUME/2, dic.Contains(key))
+	}
+}
+
+func testEncoding(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	dic := fromPairs(factory(), rand.New(rand.NewSource(1)).Perm(200))
+	if _, ok := dic.(codec.Serializable); !ok {
+		t.Skip("The map does not implement codec.Serializable")
+	}
+	_, expected := contents(t, dic)
+
+	binary, err := dic.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+	fromBinary := fromPairs(factory(), []int{-1})
+	require.NoError(t, fromBinary.(codec.Serializable).UnmarshalBinary(binary))
+	requireSameContents(t, expected, fromBinary)
+
+	text, err := json.Marshal(dic)
+	require.NoError(t, err)
+	fromJSON := factory()
+	require.NoError(t, json.Unmarshal(text, fromJSON))
+	requireSameContents(t, expected, fromJSON)
+
+	require.Error(t, fromJSON.(codec.Serializable).UnmarshalBinary(binary[:len(binary)-1]))
+	requireSameContents(t, expected, fromJSON)
+}
+
+// testRandomized applies random operations to the map and to a Go map, and checks that both always agree and,
+// if the map is a debug.Validator, that its invariants hold.
+func testRandomized(t *testing.T, factory func() ADTMap.Map[int, int]) {
+	rng := rand.New(rand.NewSource(1))
+	dic := factory()
+	model := make(map[int]int)
+	for i := 0; i < _RANDOM_OPS; i++ {
+		key := rng.Intn(_RANDOM_KEYS)
+		switch rng.Intn(4) {
+		case 0, 1:
+			dic.Save(key, i)
+			model[key] = i
+		case 2:
+			value, err := ADTMap.TryRemove(dic, key)
+			if expected, ok := model[key]; ok {
+				require.NoError(t, err)
+				require.Equal(t, expected, value)
+				delete(model, key)
+			} else {
+				require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+			}
+		default:
+			value, err := ADTMap.TryGet(dic, key)
+			if expected, ok := model[key]; ok {
+				require.NoError(t, err)
+				require.Equal(t, expected, value)
+			} else {
+				require.ErrorIs(t, err, ADTMap.ErrKeyNotFound)
+			}
+		}
+		require.NoError(t, debug.Validate(dic))
+		require.EqualValues(t, len(model), dic.Count())
+		require.Equal(t, func() bool { _, ok := model[key]; return ok }(), dic.Contains(key))
+	}
+	requireSameContents(t, model, dic)
+}
+
+// ===================== BSTMap ==========================
+
+func rangeKeys(dic ADTMap.BSTMap[int, int], from, to *int) []int {
+	keys := []int{}
+	dic.IterateRange(from, to, func(key, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func iteratorKeys(iter ADTMap.MapIterator[int, int]) []int {
+	keys := []int{}
+	for ; iter.HasNext(); iter.Next() {
+		key, _ := iter.Current()
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func testOrdered(t *testing.T, factory func(cmp func(a, b int) int) ADTMap.BSTMap[int, int]) {
+	keys := rand.New(rand.NewSource(1)).Perm(500)
+	sorted := slices.Clone(keys)
+	slices.Sort(sorted)
+
+	dic := fromPairs(factory(cmpInt), keys)
+	iterated, _ := contents(t, dic)
+	require.Equal(t, sorted, iterated, "The keys should be iterated in increasing order")
+
+	reversed := fromPairs(factory(cmpReverse), keys)
+	iterated, _ = contents(t, reversed)
+	slices.Reverse(sorted)
+	require.Equal(t, sorted, iterated, "The order of the keys should be given by the comparator")
+}
+
+func testRanges(t *testing.T, factory func(cmp func(a, b int) int) ADTMap.BSTMap[int, int]) {
+	dic := fromPairs(factory(cmpInt), []int{10, 20, 30, 40, 50})
+	from, to, missing, low, high := 20, 40, 35, 0, 100
+
+	require.Equal(t, []int{20, 30, 40}, rangeKeys(dic, &from, &to), "Both ends of the range are included")
+	require.Equal(t, []int{10, 20, 30, 40}, rangeKeys(dic, nil, &to))
+	require.Equal(t, []int{20, 30, 40, 50}, rangeKeys(dic, &from, nil))
+	require.Equal(t, []int{10, 20, 30, 40, 50}, rangeKeys(dic, nil, nil))
+	require.Equal(t, []int{40, 50}, rangeKeys(dic, &missing, &high))
+	require.Empty(t, rangeKeys(dic, &high, nil))
+	require.Empty(t, rangeKeys(dic, &to, &from), "A range whose start is after its end is empty")
+	require.Empty(t, rangeKeys(dic, &low, &low))
+
+	require.Equal(t, []int{20, 30, 40}, iteratorKeys(dic.IteratorRange(&from, &to)))
+	require.Equal(t, []int{10, 20, 30}, iteratorKeys(dic.IteratorRange(nil, &missing)))
+	require.Empty(t, iteratorKeys(dic.IteratorRange(&high, nil)))
+
+	visited := []int{}
+	dic.IterateRange(&from, nil, func(key, _ int) bool {
+		visited = append(visited, key)
+		return key < 30
+	})
+	require.Equal(t, []int{20, 30}, visited, "The iteration should stop when visit returns false")
+}
+
+func testSeek(t *testing.T, factory func(cmp func(a, b int) int) ADTMap.BSTMap[int, int]) {
+	dic := fromPairs(factory(cmpInt), []int{10, 20, 30, 40, 50})
+	from, to := 20, 40
+	iter, ok := dic.IteratorRange(&from, &to).(ADTMap.BSTMapIterator[int, int])
+	if !ok {
+		t.Skip("The iterators of the map do not implement BSTMapIterator")
+	}
+
+	iter.Seek(30)
+	require.Equal(t, []int{30, 40}, iteratorKeys(iter))
+	iter.Seek(25)
+	key, _ := iter.Current()
+	require.Equal(t, 30, key, "Seeking a missing key should move to the next one")
+	iter.Seek(0)
+	key, _ = iter.Current()
+	require.Equal(t, 20, key, "Keys lower than the beginning of the range are never reached")
+	iter.Seek(45)
+	require.False(t, iter.HasNext())
+}
+
+func testIteratorDelete(t *testing.T, factory func(cmp func(a, b int) int) ADTMap.BSTMap[int, int]) {
+	dic := fromPairs(factory(cmpInt), rand.New(rand.NewSource(1)).Perm(_DELETE_KEYS))
+	iterator := func() ADTMap.BSTMapIterator[int, int] {
+		iter, ok := dic.IteratorRange(nil, nil).(ADTMap.BSTMapIterator[int, int])
+		if !ok {
+			t.Skip("The iterators of the map do not implement BSTMapIterator")
+		}
+		return iter
+	}
+
+	// Deleting runs of consecutive keys, as well as isolated ones, covers the removal of nodes with any
+	// number of children
+	iter := iterator()
+	expected := make(map[int]int)
+	visited := []int{}
+	for iter.HasNext() {
+		key, value := iter.Current()
+		visited = append(visited, key)
+		if key%3 == 0 || key%7 < 3 {
+			require.Equal(t, value, iter.Delete())
+		} else {
+			expected[key] = value
+			iter.Next()
+		}
+	}
+	for i, key := range visited {
+		require.Equal(t, i, key, "Deleting should move the iterator to the next key")
+	}
+	require.PanicsWithValue(t, _ITERATOR_FINISH, func() { iter.Delete() })
+	requireSameContents(t, expected, dic)
+
+	iter = iterator()
+	other := dic.IteratorRange(nil, nil)
+	iter.Delete()
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { other.HasNext() },
+		"Deleting through an iterator should invalidate the other ones")
+}
+
+// testRandomizedRanges applies random operations to the map and to a sorted slice of keys, and checks that
+// both always agree on the keys of random ranges.
+func testRandomizedRanges(t *testing.T, factory func(cmp func(a, b int) int) ADTMap.BSTMap[int, int]) {
+	rng := rand.New(rand.NewSource(2))
+	dic := factory(cmpInt)
+	model := []int{}
+	for i := 0; i < _RANDOM_OPS; i++ {
+		key := rng.Intn(_RANDOM_KEYS)
+		index, found := slices.BinarySearch(model, key)
+		if rng.Intn(3) < 2 {
+			dic.Save(key, key*10)
+			if !found {
+				model = slices.Insert(model, index, key)
+			}
+		} else if found {
+			dic.Remove(key)
+			model = slices.Delete(model, index, index+1)
+		}
+
+		from, to := rng.Intn(_RANDOM_KEYS), rng.Intn(_RANDOM_KEYS)
+		start, _ := slices.BinarySearch(model, from)
+		end, _ := slices.BinarySearch(model, to+1)
+		expected := []int{}
+		if start < end {
+			expected = model[start:end]
+		}
+		require.Equal(t, expected, rangeKeys(dic, &from, &to))
+		if i%100 == 0 {
+			require.Equal(t, expected, iteratorKeys(dic.IteratorRange(&from, &to)))
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package mymap_test
+
+import (
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+const _CONCURRENT_MODIFICATION = "The map was modified while iterating"
+
+func TestIteratorFailsOnModification(t *testing.T) {
+	factories := map[string]func() ADTMap.Map[int, int]{
+		"Hash":       ADTMap.NewHash[int, int],
+		"LinkedHash": ADTMap.NewLinkedHash[int, int],
+	}
+	for name, create := range orderedMapFactories() {
+		factories[name] = func() ADTMap.Map[int, int] { return create() }
+	}
+
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			dic := create()
+			for i := 0; i < 10; i++ {
+				dic.Save(i, i)
+			}
+
+			iter := dic.Iterator()
+			dic.Save(3, 30)
+			require.True(t, iter.HasNext(), "Updating a value should not invalidate the iterator")
+
+			dic.Save(100, 100)
+			require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.HasNext() })
+			require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.Current() })
+			require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.Next() })
+
+			iter = dic.Iterator()
+			dic.Remove(100)
+			require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.HasNext() })
+
+			// Saving enough keys to resize the hash table, which used to go unnoticed
+			iter = dic.Iterator()
+			for i := 10; i < 1000; i++ {
+				dic.Save(i, i)
+			}
+			require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.HasNext() })
+
+			iter = dic.Iterator()
+			binary, err := dic.(codec.Serializable).MarshalBinary()
+			require.NoError(t, err)
+			require.NoError(t, dic.(codec.Serializable).UnmarshalBinary(binary))
+			require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.HasNext() })
+		})
+	}
+}
+
+func TestIteratorDeleteKeepsIteratorValid(t *testing.T) {
+	for name, create := range orderedMapFactories() {
+		t.Run(name, func(t *testing.T) {
+			dic := create()
+			for i := 0; i < 100; i++ {
+				dic.Save(i, i)
+			}
+
+			iter := bstIterator(t, dic, nil, nil)
+			visited := 0
+			for iter.HasNext() {
+				key, _ := iter.Current()
+				if key%2 == 0 {
+					iter.Delete()
+				} else {
+					iter.Next()
+				}
+				visited++
+			}
+			require.EqualValues(t, 100, visited)
+			require.EqualValues(t, 50, dic.Count())
+
+			iter = bstIterator(t, dic, nil, nil)
+			iter.Seek(51)
+			dic.Remove(1)
+			require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.Seek(0) })
+			require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.Delete() })
+		})
+	}
+}
+
+func TestLinkedHashAccessOrderInvalidatesIterator(t *testing.T) {
+	dic := ADTMap.NewLinkedHashAccessOrder[string, int]()
+	dic.Save("a", 1)
+	dic.Save("b", 2)
+
+	iter := dic.Iterator()
+	dic.Get("a")
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.HasNext() })
+}
+
+func TestRadixTreeIteratorFailsOnModification(t *testing.T) {
+	tree := ADTMap.CreateRadixTree[int]()
+	tree.Save("car", 1)
+	tree.Save("cart", 2)
+
+	iter := tree.Iterator()
+	tree.Save("cat", 3)
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() { iter.Current() })
+
+	require.PanicsWithValue(t, _CONCURRENT_MODIFICATION, func() {
+		tree.Iterate(func(key string, _ int) bool {
+			tree.Remove(key)
+			return true
+		})
+	})
+}
@@ -10,3 +10,43 @@ type BSTMap[K comparable, V any] interface {
 	// IteratorRange creates an IterMap that only iterates over the keys that are within the indicated range
 	IteratorRange(from *K, to *K) MapIterator[K, V]
 }
+
+// BSTMapIterator is implemented by the iterators of the ordered maps of this package, which can be reached
+// with a type assertion on the result of Iterator or IteratorRange.
+type BSTMapIterator[K comparable, V any] interface {
+	MapIterator[K, V]
+
+	// Seek moves the iterator to the first key of its range that is greater than or equal to the given key.
+	// Keys lower than the beginning of the range are never reached
+	Seek(key K)
+
+	// Delete removes the current element from the map and returns its value. After deleting, the iterator
+	// moves to the next element. If not HasNext, it panics with the message 'The iterator has finished iterating'.
+	// It is the safe way to remove keys while iterating, since the iterator stays valid
+	Delete() V
+}
+
+type TreapMap[K comparable, V any] interface {
+	BSTMap[K, V]
+
+	// Split divides the map into two: one with the keys lower than the given key and the other one with
+	// the keys greater than or equal to it. The map is left empty
+	Split(key K) (TreapMap[K, V], TreapMap[K, V])
+
+	// Join appends the elements of other, whose keys must all be greater than the keys of the map.
+	// Otherwise, or if other is the map itself, it panics. The other map must not be used afterwards
+	Join(other TreapMap[K, V])
+
+	// Union adds the elements of other to the map. If a key belongs to both maps, the value of other is kept.
+	// The other map must not be used afterwards, unless it is the map itself, which is left unchanged
+	Union(other TreapMap[K, V])
+}
+
+type AggregateMap[K comparable, V any, A any] interface {
+	BSTMap[K, V]
+
+	// AggregateRange returns the combination, in key order, of the measures of the elements within the
+	// indicated range. A nil bound leaves that side of the range open. If there are no elements in the range,
+	// it returns the identity of the monoid
+	AggregateRange(from *K, to *K) A
+}
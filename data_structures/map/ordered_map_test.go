@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/sebagarciad/algorithms-and-data-structures/compare"
 	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
 
 	"github.com/stretchr/testify/require"
@@ -22,299 +23,365 @@ func cmpStr(a, b string) int {
 	return strings.Compare(a, b)
 }
 
+// orderedConstructors returns the constructors of the ordered maps that the tests of this file run against.
+func orderedConstructors[K comparable, V any]() map[string]func(cmpFunc func(K, K) int) ADTMap.BSTMap[K, V] {
+	return map[string]func(cmpFunc func(K, K) int) ADTMap.BSTMap[K, V]{
+		"BST": ADTMap.CreateBST[K, V],
+		"SkipList": func(cmpFunc func(K, K) int) ADTMap.BSTMap[K, V] {
+			return ADTMap.CreateSkipListWithSeed[K, V](cmpFunc, 1)
+		},
+	}
+}
+
 func TestDiccionarioOrdenadoVacio(t *testing.T) {
 	t.Log("Comprueba que Diccionario vacio no tiene claves")
-	dic := ADTMap.CreateBST[int, string](cmpInt)
-	require.EqualValues(t, 0, dic.Count(), "La cantidad de un diccionario vacio debe ser 0")
-	require.False(t, dic.Contains(1), "Un diccionario vacio no tiene claves guardadas")
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get(1) })
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove(1) })
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+			require.EqualValues(t, 0, dic.Count(), "La cantidad de un diccionario vacio debe ser 0")
+			require.False(t, dic.Contains(1), "Un diccionario vacio no tiene claves guardadas")
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get(1) })
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove(1) })
+		})
+	}
 }
 
 func TestDiccionarioOrdenadoClaveDefault(t *testing.T) {
 	t.Log("Prueba sobre un Árbol vacío que si justo buscamos la clave que es el default del tipo de dato, " +
 		"sigue sin existir")
-	dic := ADTMap.CreateBST[string, string](cmpStr)
-	require.False(t, dic.Contains(""), "Debe ser false")
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get("") })
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove("") })
-
-	dicNum := ADTMap.CreateBST[int, string](cmpInt)
-	require.False(t, dicNum.Contains(0))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dicNum.Get(0) })
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dicNum.Remove(0) })
+	for name, create := range orderedConstructors[string, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpStr)
+			require.False(t, dic.Contains(""), "Debe ser false")
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get("") })
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove("") })
+
+			dicNum := orderedConstructors[int, string]()[name](cmpInt)
+			require.False(t, dicNum.Contains(0))
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dicNum.Get(0) })
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dicNum.Remove(0) })
+		})
+	}
 }
 
 func TestUnElemento(t *testing.T) {
 	t.Log("Comprueba que Diccionario con un elemento tiene esa Clave, unicamente")
-	dic := ADTMap.CreateBST[string, int](cmpStr)
-	dic.Save("A", 10)
-	require.EqualValues(t, 1, dic.Count(), "La cantidad debe ser 1")
-	require.True(t, dic.Contains("A"), "Debe devolver true")
-	require.False(t, dic.Contains("B"), "Debe devolver false")
-	require.EqualValues(t, 10, dic.Get("A"), "Debe devolver 10")
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get("B") })
+	for name, create := range orderedConstructors[string, int]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpStr)
+			dic.Save("A", 10)
+			require.EqualValues(t, 1, dic.Count(), "La cantidad debe ser 1")
+			require.True(t, dic.Contains("A"), "Debe devolver true")
+			require.False(t, dic.Contains("B"), "Debe devolver false")
+			require.EqualValues(t, 10, dic.Get("A"), "Debe devolver 10")
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get("B") })
+		})
+	}
 }
 
 func TestDiccionarioOrdenadoGuardar(t *testing.T) {
 	t.Log("Guarda algunos pocos elementos en el diccionario, y se comprueba que en todo momento funciona acorde")
-	clave1 := "Perro"
-	clave2 := "Gato"
-	clave3 := "Vaca"
-	valor1 := "guau"
-	valor2 := "miau"
-	valor3 := "moo"
-	claves := []string{clave1, clave2, clave3}
-	valores := []string{valor1, valor2, valor3}
-
-	dic := ADTMap.CreateBST[string, string](cmpStr)
-	require.False(t, dic.Contains(claves[0]))
-	require.False(t, dic.Contains(claves[0]))
-	dic.Save(claves[0], valores[0])
-	require.EqualValues(t, 1, dic.Count())
-	require.True(t, dic.Contains(claves[0]))
-	require.True(t, dic.Contains(claves[0]))
-	require.EqualValues(t, valores[0], dic.Get(claves[0]))
-	require.EqualValues(t, valores[0], dic.Get(claves[0]))
-
-	require.False(t, dic.Contains(claves[1]))
-	require.False(t, dic.Contains(claves[2]))
-	dic.Save(claves[1], valores[1])
-	require.True(t, dic.Contains(claves[0]))
-	require.True(t, dic.Contains(claves[1]))
-	require.EqualValues(t, 2, dic.Count())
-	require.EqualValues(t, valores[0], dic.Get(claves[0]))
-	require.EqualValues(t, valores[1], dic.Get(claves[1]))
-
-	require.False(t, dic.Contains(claves[2]))
-	dic.Save(claves[2], valores[2])
-	require.True(t, dic.Contains(claves[0]))
-	require.True(t, dic.Contains(claves[1]))
-	require.True(t, dic.Contains(claves[2]))
-	require.EqualValues(t, 3, dic.Count())
-	require.EqualValues(t, valores[0], dic.Get(claves[0]))
-	require.EqualValues(t, valores[1], dic.Get(claves[1]))
-	require.EqualValues(t, valores[2], dic.Get(claves[2]))
+	for name, create := range orderedConstructors[string, string]() {
+		t.Run(name, func(t *testing.T) {
+			clave1 := "Perro"
+			clave2 := "Gato"
+			clave3 := "Vaca"
+			valor1 := "guau"
+			valor2 := "miau"
+			valor3 := "moo"
+			claves := []string{clave1, clave2, clave3}
+			valores := []string{valor1, valor2, valor3}
+
+			dic := create(cmpStr)
+			require.False(t, dic.Contains(claves[0]))
+			require.False(t, dic.Contains(claves[0]))
+			dic.Save(claves[0], valores[0])
+			require.EqualValues(t, 1, dic.Count())
+			require.True(t, dic.Contains(claves[0]))
+			require.True(t, dic.Contains(claves[0]))
+			require.EqualValues(t, valores[0], dic.Get(claves[0]))
+			require.EqualValues(t, valores[0], dic.Get(claves[0]))
+
+			require.False(t, dic.Contains(claves[1]))
+			require.False(t, dic.Contains(claves[2]))
+			dic.Save(claves[1], valores[1])
+			require.True(t, dic.Contains(claves[0]))
+			require.True(t, dic.Contains(claves[1]))
+			require.EqualValues(t, 2, dic.Count())
+			require.EqualValues(t, valores[0], dic.Get(claves[0]))
+			require.EqualValues(t, valores[1], dic.Get(claves[1]))
+
+			require.False(t, dic.Contains(claves[2]))
+			dic.Save(claves[2], valores[2])
+			require.True(t, dic.Contains(claves[0]))
+			require.True(t, dic.Contains(claves[1]))
+			require.True(t, dic.Contains(claves[2]))
+			require.EqualValues(t, 3, dic.Count())
+			require.EqualValues(t, valores[0], dic.Get(claves[0]))
+			require.EqualValues(t, valores[1], dic.Get(claves[1]))
+			require.EqualValues(t, valores[2], dic.Get(claves[2]))
+		})
+	}
 }
 
 func TestDiccOrdReemplazoDato(t *testing.T) {
 	t.Log("Guarda un par de claves, y luego vuelve a guardar, buscando que el dato se haya reemplazado")
-	clave := "Perro"
-	clave2 := "Gato"
-	dic := ADTMap.CreateBST[string, string](cmpStr)
-	dic.Save(clave, "guau")
-	dic.Save(clave2, "miau")
-	require.True(t, dic.Contains(clave))
-	require.True(t, dic.Contains(clave2))
-	require.EqualValues(t, "guau", dic.Get(clave))
-	require.EqualValues(t, "miau", dic.Get(clave2))
-	require.EqualValues(t, 2, dic.Count())
-
-	dic.Save(clave, "baubau")
-	dic.Save(clave2, "miu")
-	require.True(t, dic.Contains(clave))
-	require.True(t, dic.Contains(clave2))
-	require.EqualValues(t, 2, dic.Count())
-	require.EqualValues(t, "baubau", dic.Get(clave))
-	require.EqualValues(t, "miu", dic.Get(clave2))
+	for name, create := range orderedConstructors[string, string]() {
+		t.Run(name, func(t *testing.T) {
+			clave := "Perro"
+			clave2 := "Gato"
+			dic := create(cmpStr)
+			dic.Save(clave, "guau")
+			dic.Save(clave2, "miau")
+			require.True(t, dic.Contains(clave))
+			require.True(t, dic.Contains(clave2))
+			require.EqualValues(t, "guau", dic.Get(clave))
+			require.EqualValues(t, "miau", dic.Get(clave2))
+			require.EqualValues(t, 2, dic.Count())
+
+			dic.Save(clave, "baubau")
+			dic.Save(clave2, "miu")
+			require.True(t, dic.Contains(clave))
+			require.True(t, dic.Contains(clave2))
+			require.EqualValues(t, 2, dic.Count())
+			require.EqualValues(t, "baubau", dic.Get(clave))
+			require.EqualValues(t, "miu", dic.Get(clave2))
+		})
+	}
 }
 
 func TestDicOrdReemplazoDatoHopscotch(t *testing.T) {
 	t.Log("Guarda bastantes claves, y luego reemplaza sus datos. Luego valida que todos los datos sean " +
 		"correctos. Para una implementación Hopscotch, detecta errores al hacer lugar o guardar elementos.")
+	for name, create := range orderedConstructors[int, int]() {
+		t.Run(name, func(t *testing.T) {
 
-	dic := ADTMap.CreateBST[int, int](cmpInt)
-	keys := rand.Perm(1000) // Genera una permutación aleatoria de 0 a 499
+			dic := create(cmpInt)
+			keys := rand.Perm(1000) // Genera una permutación aleatoria de 0 a 499
 
-	for _, key := range keys {
-		dic.Save(key, key) // Guarda los datos originales
-	}
+			for _, key := range keys {
+				dic.Save(key, key) // Guarda los datos originales
+			}
 
-	for _, key := range keys {
-		dic.Save(key, 2*key) // Reemplaza con el doble
-	}
+			for _, key := range keys {
+				dic.Save(key, 2*key) // Reemplaza con el doble
+			}
 
-	// Se verifica que los datos hayan sido reemplazados
-	ok := true
-	for _, key := range keys {
-		ok = dic.Get(key) == 2*key
-		if !ok {
-			break
-		}
-	}
+			// Se verifica que los datos hayan sido reemplazados
+			ok := true
+			for _, key := range keys {
+				ok = dic.Get(key) == 2*key
+				if !ok {
+					break
+				}
+			}
 
-	require.True(t, ok, "Los elementos no fueron actualizados correctamente")
+			require.True(t, ok, "Los elementos no fueron actualizados correctamente")
+		})
+	}
 }
 
 func TestDiccionarioOrdenadoBorrar(t *testing.T) {
 	t.Log("Guarda algunos pocos elementos en el diccionario, y se los borra, revisando que en todo momento " +
 		"el diccionario se comporte de manera adecuada")
-	clave1 := 3
-	clave2 := 1
-	clave3 := 0
-	valor1 := "miau"
-	valor2 := "guau"
-	valor3 := "moo"
-	claves := []int{clave1, clave2, clave3}
-	valores := []string{valor1, valor2, valor3}
-	dic := ADTMap.CreateBST[int, string](cmpInt)
-
-	require.False(t, dic.Contains(claves[0]))
-	require.False(t, dic.Contains(claves[0]))
-	dic.Save(claves[0], valores[0])
-	dic.Save(claves[1], valores[1])
-	dic.Save(claves[2], valores[2])
-
-	require.True(t, dic.Contains(claves[2]))
-	require.EqualValues(t, valores[2], dic.Remove(claves[2]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove(claves[2]) })
-	require.EqualValues(t, 2, dic.Count())
-	require.False(t, dic.Contains(claves[2]))
-
-	require.True(t, dic.Contains(claves[0]))
-	require.EqualValues(t, valores[0], dic.Remove(claves[0]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove(claves[0]) })
-	require.EqualValues(t, 1, dic.Count())
-	require.False(t, dic.Contains(claves[0]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get(claves[0]) })
-
-	require.True(t, dic.Contains(claves[1]))
-	require.EqualValues(t, valores[1], dic.Remove(claves[1]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove(claves[1]) })
-	require.EqualValues(t, 0, dic.Count())
-	require.False(t, dic.Contains(claves[1]))
-	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get(claves[1]) })
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			clave1 := 3
+			clave2 := 1
+			clave3 := 0
+			valor1 := "miau"
+			valor2 := "guau"
+			valor3 := "moo"
+			claves := []int{clave1, clave2, clave3}
+			valores := []string{valor1, valor2, valor3}
+			dic := create(cmpInt)
+
+			require.False(t, dic.Contains(claves[0]))
+			require.False(t, dic.Contains(claves[0]))
+			dic.Save(claves[0], valores[0])
+			dic.Save(claves[1], valores[1])
+			dic.Save(claves[2], valores[2])
+
+			require.True(t, dic.Contains(claves[2]))
+			require.EqualValues(t, valores[2], dic.Remove(claves[2]))
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove(claves[2]) })
+			require.EqualValues(t, 2, dic.Count())
+			require.False(t, dic.Contains(claves[2]))
+
+			require.True(t, dic.Contains(claves[0]))
+			require.EqualValues(t, valores[0], dic.Remove(claves[0]))
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove(claves[0]) })
+			require.EqualValues(t, 1, dic.Count())
+			require.False(t, dic.Contains(claves[0]))
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get(claves[0]) })
+
+			require.True(t, dic.Contains(claves[1]))
+			require.EqualValues(t, valores[1], dic.Remove(claves[1]))
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Remove(claves[1]) })
+			require.EqualValues(t, 0, dic.Count())
+			require.False(t, dic.Contains(claves[1]))
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Get(claves[1]) })
+		})
+	}
 }
 
 func TestDicOrdConClavesNumericas(t *testing.T) {
 	t.Log("Valida que no solo funcione con strings")
-	dic := ADTMap.CreateBST[int, string](cmpInt)
-	clave := 10
-	valor := "Gatito"
-
-	dic.Save(clave, valor)
-	require.EqualValues(t, 1, dic.Count())
-	require.True(t, dic.Contains(clave))
-	require.EqualValues(t, valor, dic.Get(clave))
-	require.EqualValues(t, valor, dic.Remove(clave))
-	require.False(t, dic.Contains(clave))
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+			clave := 10
+			valor := "Gatito"
+
+			dic.Save(clave, valor)
+			require.EqualValues(t, 1, dic.Count())
+			require.True(t, dic.Contains(clave))
+			require.EqualValues(t, valor, dic.Get(clave))
+			require.EqualValues(t, valor, dic.Remove(clave))
+			require.False(t, dic.Contains(clave))
+		})
+	}
 }
 
 func TestDicOrdClaveVacia(t *testing.T) {
 	t.Log("Guardamos una clave vacía (i.e. \"\") y deberia funcionar sin problemas")
-	dic := ADTMap.CreateBST[string, string](cmpStr)
-	clave := ""
-	dic.Save(clave, clave)
-	require.True(t, dic.Contains(clave))
-	require.EqualValues(t, 1, dic.Count())
-	require.EqualValues(t, clave, dic.Get(clave))
+	for name, create := range orderedConstructors[string, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpStr)
+			clave := ""
+			dic.Save(clave, clave)
+			require.True(t, dic.Contains(clave))
+			require.EqualValues(t, 1, dic.Count())
+			require.EqualValues(t, clave, dic.Get(clave))
+		})
+	}
 }
 
 func TestDicOrdValorNulo(t *testing.T) {
 	t.Log("Probamos que el valor puede ser nil sin problemas")
-	dic := ADTMap.CreateBST[string, *int](cmpStr)
-	clave := "Pez"
-	dic.Save(clave, nil)
-	require.True(t, dic.Contains(clave))
-	require.EqualValues(t, 1, dic.Count())
-	require.EqualValues(t, (*int)(nil), dic.Get(clave))
-	require.EqualValues(t, (*int)(nil), dic.Remove(clave))
-	require.False(t, dic.Contains(clave))
+	for name, create := range orderedConstructors[string, *int]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpStr)
+			clave := "Pez"
+			dic.Save(clave, nil)
+			require.True(t, dic.Contains(clave))
+			require.EqualValues(t, 1, dic.Count())
+			require.EqualValues(t, (*int)(nil), dic.Get(clave))
+			require.EqualValues(t, (*int)(nil), dic.Remove(clave))
+			require.False(t, dic.Contains(clave))
+		})
+	}
 }
 
 func TestDicOrdGuardarYBorrarRepetidasVeces(t *testing.T) {
 	t.Log("Esta prueba guarda y borra repetidas veces.")
+	for name, create := range orderedConstructors[int, int]() {
+		t.Run(name, func(t *testing.T) {
 
-	dic := ADTMap.CreateBST[int, int](cmpInt)
-	keys := rand.Perm(1000) // Genera una permutación aleatoria de 0 a 999
+			dic := create(cmpInt)
+			keys := rand.Perm(1000) // Genera una permutación aleatoria de 0 a 999
 
-	for _, key := range keys {
-		dic.Save(key, key) // Guarda el elemento
-		require.True(t, dic.Contains(key), "El elemento debería pertenecer al diccionario después de guardarlo")
-		dic.Remove(key) // Borra el elemento
-		require.False(t, dic.Contains(key), "El elemento no debería pertenecer al diccionario después de borrarlo")
+			for _, key := range keys {
+				dic.Save(key, key) // Guarda el elemento
+				require.True(t, dic.Contains(key), "El elemento debería pertenecer al diccionario después de guardarlo")
+				dic.Remove(key) // Borra el elemento
+				require.False(t, dic.Contains(key), "El elemento no debería pertenecer al diccionario después de borrarlo")
+			}
+		})
 	}
 }
 
 func TestDicOrdIteradorInternoClaves(t *testing.T) {
 	t.Log("Valida que todas las claves sean recorridas (y una única vez) con el iterador interno")
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	claves := []string{clave1, clave2, clave3}
-	dic := ADTMap.CreateBST[string, *int](cmpStr)
-	dic.Save(claves[0], nil)
-	dic.Save(claves[1], nil)
-	dic.Save(claves[2], nil)
-
-	cs := []string{"", "", ""}
-	cantidad := 0
-	cantPtr := &cantidad
-
-	dic.Iterate(func(clave string, dato *int) bool {
-		cs[cantidad] = clave
-		*cantPtr = *cantPtr + 1
-		return true
-	})
-
-	require.EqualValues(t, 3, cantidad)
-	require.NotEqualValues(t, cs[0], cs[1])
-	require.NotEqualValues(t, cs[0], cs[2])
-	require.NotEqualValues(t, cs[2], cs[1])
+	for name, create := range orderedConstructors[string, *int]() {
+		t.Run(name, func(t *testing.T) {
+			clave1 := "Gato"
+			clave2 := "Perro"
+			clave3 := "Vaca"
+			claves := []string{clave1, clave2, clave3}
+			dic := create(cmpStr)
+			dic.Save(claves[0], nil)
+			dic.Save(claves[1], nil)
+			dic.Save(claves[2], nil)
+
+			cs := []string{"", "", ""}
+			cantidad := 0
+			cantPtr := &cantidad
+
+			dic.Iterate(func(clave string, dato *int) bool {
+				cs[cantidad] = clave
+				*cantPtr = *cantPtr + 1
+				return true
+			})
+
+			require.EqualValues(t, 3, cantidad)
+			require.NotEqualValues(t, cs[0], cs[1])
+			require.NotEqualValues(t, cs[0], cs[2])
+			require.NotEqualValues(t, cs[2], cs[1])
+		})
+	}
 }
 
 func TestDicOrdIteradorInternoValores(t *testing.T) {
 	t.Log("Valida que los datos sean recorridas correctamente (y una única vez) con el iterador interno")
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	clave4 := "Burrito"
-	clave5 := "Hamster"
-
-	dic := ADTMap.CreateBST[string, int](cmpStr)
-	dic.Save(clave1, 6)
-	dic.Save(clave2, 2)
-	dic.Save(clave3, 3)
-	dic.Save(clave4, 4)
-	dic.Save(clave5, 5)
-
-	factorial := 1
-	ptrFactorial := &factorial
-	dic.Iterate(func(_ string, dato int) bool {
-		*ptrFactorial *= dato
-		return true
-	})
-
-	require.EqualValues(t, 720, factorial)
+	for name, create := range orderedConstructors[string, int]() {
+		t.Run(name, func(t *testing.T) {
+			clave1 := "Gato"
+			clave2 := "Perro"
+			clave3 := "Vaca"
+			clave4 := "Burrito"
+			clave5 := "Hamster"
+
+			dic := create(cmpStr)
+			dic.Save(clave1, 6)
+			dic.Save(clave2, 2)
+			dic.Save(clave3, 3)
+			dic.Save(clave4, 4)
+			dic.Save(clave5, 5)
+
+			factorial := 1
+			ptrFactorial := &factorial
+			dic.Iterate(func(_ string, dato int) bool {
+				*ptrFactorial *= dato
+				return true
+			})
+
+			require.EqualValues(t, 720, factorial)
+		})
+	}
 }
 
 func TestDicOrdIteradorInternoValoresConBorrados(t *testing.T) {
 	t.Log("Valida que los datos sean recorridas correctamente (y una única vez) con el iterador interno, sin recorrer datos borrados")
-	clave0 := "Elefante"
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	clave4 := "Burrito"
-	clave5 := "Hamster"
-
-	dic := ADTMap.CreateBST[string, int](cmpStr)
-	dic.Save(clave0, 7)
-	dic.Save(clave1, 6)
-	dic.Save(clave2, 2)
-	dic.Save(clave3, 3)
-	dic.Save(clave4, 4)
-	dic.Save(clave5, 5)
-
-	dic.Remove(clave0)
-
-	factorial := 1
-	ptrFactorial := &factorial
-	dic.Iterate(func(_ string, dato int) bool {
-		*ptrFactorial *= dato
-		return true
-	})
-
-	require.EqualValues(t, 720, factorial)
+	for name, create := range orderedConstructors[string, int]() {
+		t.Run(name, func(t *testing.T) {
+			clave0 := "Elefante"
+			clave1 := "Gato"
+			clave2 := "Perro"
+			clave3 := "Vaca"
+			clave4 := "Burrito"
+			clave5 := "Hamster"
+
+			dic := create(cmpStr)
+			dic.Save(clave0, 7)
+			dic.Save(clave1, 6)
+			dic.Save(clave2, 2)
+			dic.Save(clave3, 3)
+			dic.Save(clave4, 4)
+			dic.Save(clave5, 5)
+
+			dic.Remove(clave0)
+
+			factorial := 1
+			ptrFactorial := &factorial
+			dic.Iterate(func(_ string, dato int) bool {
+				*ptrFactorial *= dato
+				return true
+			})
+
+			require.EqualValues(t, 720, factorial)
+		})
+	}
 }
 
 func ejecutarPruebaVolumenDicOrd(b *testing.B, n int) {
@@ -388,80 +455,92 @@ func BenchmarkDiccionarioOrdenado(b *testing.B) {
 
 func TestIterarDiccionarioOrdenadoVacio(t *testing.T) {
 	t.Log("Iterar sobre diccionario vacio es simplemente tenerlo al final")
-	dic := ADTMap.CreateBST[string, int](cmpStr)
-	iter := dic.Iterator()
-	require.False(t, iter.HasNext())
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Current() })
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Next() })
+	for name, create := range orderedConstructors[string, int]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpStr)
+			iter := dic.Iterator()
+			require.False(t, iter.HasNext())
+			require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Current() })
+			require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Next() })
+		})
+	}
 }
 
 func TestDiccionarioOrdenadoIterar(t *testing.T) {
 	t.Log("Guardamos 3 valores en un Diccionario, e iteramos validando que las claves sean todas diferentes " +
 		"pero pertenecientes al diccionario. Además los valores de VerActual y Siguiente van siendo correctos entre sí")
-
-	clave1 := "Gato"
-	clave2 := "Perro"
-	clave3 := "Vaca"
-	valor1 := "miau"
-	valor2 := "guau"
-	valor3 := "moo"
-	claves := []string{clave1, clave2, clave3}
-	valores := []string{valor1, valor2, valor3}
-	dic := ADTMap.CreateBST[string, string](cmpStr)
-
-	// Guardar las claves y valores en el diccionario
-	dic.Save(claves[0], valores[0])
-	dic.Save(claves[1], valores[1])
-	dic.Save(claves[2], valores[2])
-
-	iter := dic.Iterator()
-
-	require.True(t, iter.HasNext())
-	primero, _ := iter.Current()
-	require.True(t, dic.Contains(primero)) // Verifica si la clave pertenece al diccionario
-
-	iter.Next()
-	segundo, segundo_valor := iter.Current()
-	require.True(t, dic.Contains(segundo))
-	require.EqualValues(t, valores[1], segundo_valor) // Verifica el valor correspondiente
-	require.NotEqualValues(t, primero, segundo)
-	require.True(t, iter.HasNext())
-
-	iter.Next()
-	require.True(t, iter.HasNext())
-	tercero, _ := iter.Current()
-	require.True(t, dic.Contains(tercero))
-	require.NotEqualValues(t, primero, tercero)
-	require.NotEqualValues(t, segundo, tercero)
-
-	iter.Next()
-	require.False(t, iter.HasNext())
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Current() })
-	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Next() })
+	for name, create := range orderedConstructors[string, string]() {
+		t.Run(name, func(t *testing.T) {
+
+			clave1 := "Gato"
+			clave2 := "Perro"
+			clave3 := "Vaca"
+			valor1 := "miau"
+			valor2 := "guau"
+			valor3 := "moo"
+			claves := []string{clave1, clave2, clave3}
+			valores := []string{valor1, valor2, valor3}
+			dic := create(cmpStr)
+
+			// Guardar las claves y valores en el diccionario
+			dic.Save(claves[0], valores[0])
+			dic.Save(claves[1], valores[1])
+			dic.Save(claves[2], valores[2])
+
+			iter := dic.Iterator()
+
+			require.True(t, iter.HasNext())
+			primero, _ := iter.Current()
+			require.True(t, dic.Contains(primero)) // Verifica si la clave pertenece al diccionario
+
+			iter.Next()
+			segundo, segundo_valor := iter.Current()
+			require.True(t, dic.Contains(segundo))
+			require.EqualValues(t, valores[1], segundo_valor) // Verifica el valor correspondiente
+			require.NotEqualValues(t, primero, segundo)
+			require.True(t, iter.HasNext())
+
+			iter.Next()
+			require.True(t, iter.HasNext())
+			tercero, _ := iter.Current()
+			require.True(t, dic.Contains(tercero))
+			require.NotEqualValues(t, primero, tercero)
+			require.NotEqualValues(t, segundo, tercero)
+
+			iter.Next()
+			require.False(t, iter.HasNext())
+			require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Current() })
+			require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Next() })
+		})
+	}
 }
 
 func TestDicOrdIteradorNoLlegaAlFinal(t *testing.T) {
 	t.Log("Crea un iterador y no lo avanza. Luego crea otro iterador y lo avanza.")
-	dic := ADTMap.CreateBST[string, string](cmpStr)
-	claves := []string{"A", "B", "C"}
-	dic.Save(claves[0], "")
-	dic.Save(claves[1], "")
-	dic.Save(claves[2], "")
-
-	dic.Iterator()
-	iter2 := dic.Iterator()
-	iter2.Next()
-	iter3 := dic.Iterator()
-	primero, _ := iter3.Current()
-	iter3.Next()
-	segundo, _ := iter3.Current()
-	iter3.Next()
-	tercero, _ := iter3.Current()
-	iter3.Next()
-	require.False(t, iter3.HasNext())
-	require.NotEqualValues(t, primero, segundo)
-	require.NotEqualValues(t, tercero, segundo)
-	require.NotEqualValues(t, primero, tercero)
+	for name, create := range orderedConstructors[string, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpStr)
+			claves := []string{"A", "B", "C"}
+			dic.Save(claves[0], "")
+			dic.Save(claves[1], "")
+			dic.Save(claves[2], "")
+
+			dic.Iterator()
+			iter2 := dic.Iterator()
+			iter2.Next()
+			iter3 := dic.Iterator()
+			primero, _ := iter3.Current()
+			iter3.Next()
+			segundo, _ := iter3.Current()
+			iter3.Next()
+			tercero, _ := iter3.Current()
+			iter3.Next()
+			require.False(t, iter3.HasNext())
+			require.NotEqualValues(t, primero, segundo)
+			require.NotEqualValues(t, tercero, segundo)
+			require.NotEqualValues(t, primero, tercero)
+		})
+	}
 }
 
 func ejecutarDicOrdPruebasVolumenIterador(b *testing.B, n int) {
@@ -543,380 +622,737 @@ func BenchmarkIteradorDicOrd(b *testing.B) {
 func TestDicOrdVolumenIteradorCorte(t *testing.T) {
 	t.Log("Prueba de volumen de iterador interno, para validar que siempre que se indique que se corte" +
 		" la iteración con la función visitar, se corte")
+	for name, create := range orderedConstructors[int, int]() {
+		t.Run(name, func(t *testing.T) {
 
-	dic := ADTMap.CreateBST[int, int](cmpInt)
-
-	/* Inserta 'n' parejas en el Árbol */
-	for i := 0; i < 10000; i++ {
-		dic.Save(i, i)
-	}
-
-	seguirEjecutando := true
-	siguioEjecutandoCuandoNoDebia := false
+			dic := create(cmpInt)
 
-	dic.Iterate(func(c int, v int) bool {
-		if !seguirEjecutando {
-			siguioEjecutandoCuandoNoDebia = true
-			return false
-		}
-		if c%100 == 0 {
-			seguirEjecutando = false
-			return false
-		}
-		return true
-	})
+			/* Inserta 'n' parejas en el Árbol */
+			for i := 0; i < 10000; i++ {
+				dic.Save(i, i)
+			}
 
-	require.False(t, seguirEjecutando, "Se tendría que haber encontrado un elemento que genere el corte")
-	require.False(t, siguioEjecutandoCuandoNoDebia,
-		"No debería haber seguido ejecutando si encontramos un elemento que hizo que la iteración corte")
+			seguirEjecutando := true
+			siguioEjecutandoCuandoNoDebia := false
+
+			dic.Iterate(func(c int, v int) bool {
+				if !seguirEjecutando {
+					siguioEjecutandoCuandoNoDebia = true
+					return false
+				}
+				if c%100 == 0 {
+					seguirEjecutando = false
+					return false
+				}
+				return true
+			})
+
+			require.False(t, seguirEjecutando, "Se tendría que haber encontrado un elemento que genere el corte")
+			require.False(t, siguioEjecutandoCuandoNoDebia,
+				"No debería haber seguido ejecutando si encontramos un elemento que hizo que la iteración corte")
+		})
+	}
 }
 
 // Pruebas iterador interno
 func TestIteradorInternoRangoAbbNulo(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
 
-	desde := 5
-	hasta := 15
-	suma := 0
+			desde := 5
+			hasta := 15
+			suma := 0
 
-	visitar := func(clave int, valor string) bool {
-		suma += clave
-		return true
-	}
+			visitar := func(clave int, valor string) bool {
+				suma += clave
+				return true
+			}
 
-	dic.IterateRange(&desde, &hasta, visitar)
-	require.Equal(t, 0, suma, "La suma de todos los elementos de un arbol nulo debe ser 0")
+			dic.IterateRange(&desde, &hasta, visitar)
+			require.Equal(t, 0, suma, "La suma de todos los elementos de un arbol nulo debe ser 0")
+		})
+	}
 }
 
 func TestIteradorInternoRangoSinCorte(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
-
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
-
-	// Iterar entre 5 y 15 sin condicion de corte
-	desde := 5
-	hasta := 15
-	suma := 0
-	res := 5 + 7 + 10 + 12 + 15
-
-	visitar := func(clave int, valor string) bool {
-		suma += clave
-		return true
-	}
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			// Iterar entre 5 y 15 sin condicion de corte
+			desde := 5
+			hasta := 15
+			suma := 0
+			res := 5 + 7 + 10 + 12 + 15
+
+			visitar := func(clave int, valor string) bool {
+				suma += clave
+				return true
+			}
 
-	dic.IterateRange(&desde, &hasta, visitar)
-	require.Equal(t, res, suma, "La suma debe ser igual a la variable res")
+			dic.IterateRange(&desde, &hasta, visitar)
+			require.Equal(t, res, suma, "La suma debe ser igual a la variable res")
+		})
+	}
 }
 
 func TestIteradorInternoConCorte(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			suma := 0
+			desde := 5
+			hasta := 15
+
+			// Iterar entre 5 y 15 con condicion de corte (la iteracion corta cuando encuentra la primera clave par)
+			visitar1 := func(clave int, valor string) bool {
+				suma += clave
+				return clave%2 != 0
+			}
+			res := 5 + 7 + 10
+			dic.IterateRange(&desde, &hasta, visitar1)
+			require.Equal(t, res, suma, "La suma debe ser igual a la variable res")
+		})
+	}
+}
+
+func TestIteradorInternoSinRango(t *testing.T) {
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			suma := 0
+
+			// Iterar sin rango
+			visitar2 := func(clave int, valor string) bool {
+				suma += clave
+				return true
+			}
 
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
+			res := 3 + 5 + 7 + 10 + 12 + 15 + 20
+			dic.IterateRange(nil, nil, visitar2)
+			require.Equal(t, res, suma, "La suma debe ser igual a la variable res")
+		})
+	}
+}
 
-	suma := 0
-	desde := 5
-	hasta := 15
+func TestIteradorInternoEnRangoInexistente(t *testing.T) {
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			suma := 0
+
+			// Iterar en un rango sin claves (25-30)
+			desde := 25
+			hasta := 30
+
+			visitar3 := func(clave int, valor string) bool {
+				suma += clave
+				return true
+			}
 
-	// Iterar entre 5 y 15 con condicion de corte (la iteracion corta cuando encuentra la primera clave par)
-	visitar1 := func(clave int, valor string) bool {
-		suma += clave
-		return clave%2 != 0
+			dic.IterateRange(&desde, &hasta, visitar3)
+			require.Equal(t, 0, suma, "La suma de los elementos de un rango inexistente debe ser 0")
+		})
 	}
-	res := 5 + 7 + 10
-	dic.IterateRange(&desde, &hasta, visitar1)
-	require.Equal(t, res, suma, "La suma debe ser igual a la variable res")
 }
 
-func TestIteradorInternoSinRango(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
+func TestIteradorInternoRecorridoInOrder(t *testing.T) {
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			// Guardar elementos en el diccionario
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			// Prueba de recorrido in-order (desde = 5, hasta = nil)
+			desde := 5
+			arr := []int{}
+
+			visitar4 := func(clave int, valor string) bool {
+				arr = append(arr, clave)
+				return true
+			}
 
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
+			dic.IterateRange(&desde, nil, visitar4)
+			require.Equal(t, []int{5, 7, 10, 12, 15, 20}, arr, "El recorrido in-order debe ser [5, 7, 10, 12, 15, 20]")
+		})
+	}
+}
 
-	suma := 0
+// Pruebas del iterador externo
+func TestIteradorExternoRango(t *testing.T) {
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			// Guardar elementos en el diccionario
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			// Iterar desde 5 hasta 12 in-order
+			desde := 5
+			hasta := 12
+
+			iter := dic.IteratorRange(&desde, &hasta)
+			require.True(t, iter.HasNext(), "Debe devolver true")
+
+			clave, valor := iter.Current()
+			require.Equal(t, 5, clave, "La clave debe ser 5")
+			require.Equal(t, "cinco", valor, "El valor debe ser 'cinco'")
+			iter.Next()
+
+			clave, valor = iter.Current()
+			require.Equal(t, 7, clave, "La clave debe ser 7")
+			require.Equal(t, "siete", valor, "El valor debe ser 'siete'")
+			iter.Next()
+
+			clave, valor = iter.Current()
+			require.Equal(t, 10, clave, "La clave debe ser 10")
+			require.Equal(t, "diez", valor, "El valor debe ser 'diez'")
+			iter.Next()
+
+			clave, valor = iter.Current()
+			require.Equal(t, 12, clave, "La clave debe ser 12")
+			require.Equal(t, "doce", valor, "El valor debe ser 'doce'")
+			iter.Next()
+			require.False(t, iter.HasNext(), "Debe devolver false")
+			require.Panics(t, func() { iter.Next() })
+			require.Panics(t, func() { iter.Current() })
+		})
+	}
+}
 
-	// Iterar sin rango
-	visitar2 := func(clave int, valor string) bool {
-		suma += clave
-		return true
+func TestIterarRangoSinElementos(t *testing.T) {
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			// Iterar en un rango sin elementos (25-30)
+
+			desde2 := 25
+			hasta2 := 30
+
+			iter2 := dic.IteratorRange(&desde2, &hasta2)
+			require.False(t, iter2.HasNext(), "Debe devolver false")
+			require.Panics(t, func() { iter2.Next() })
+			require.Panics(t, func() { iter2.Current() })
+		})
 	}
+}
 
-	res := 3 + 5 + 7 + 10 + 12 + 15 + 20
-	dic.IterateRange(nil, nil, visitar2)
-	require.Equal(t, res, suma, "La suma debe ser igual a la variable res")
+func TestIterarRangoSinRango(t *testing.T) {
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			iter3 := dic.IteratorRange(nil, nil)
+			require.True(t, iter3.HasNext(), "Debe devolver true")
+
+			clave, valor := iter3.Current()
+			require.Equal(t, 3, clave, "La clave debe ser 3")
+			require.Equal(t, "tres", valor, "El valor debe ser 'tres'")
+			iter3.Next()
+
+			clave, valor = iter3.Current()
+			require.Equal(t, 5, clave, "La clave debe ser 5")
+			require.Equal(t, "cinco", valor, "El valor debe ser 'cinco'")
+			iter3.Next()
+
+			clave, valor = iter3.Current()
+			require.Equal(t, 7, clave, "La clave debe ser 7")
+			require.Equal(t, "siete", valor, "El valor debe ser 'siete'")
+			iter3.Next()
+
+			clave, valor = iter3.Current()
+			require.Equal(t, 10, clave, "La clave debe ser 10")
+			require.Equal(t, "diez", valor, "El valor debe ser 'diez'")
+			iter3.Next()
+
+			clave, valor = iter3.Current()
+			require.Equal(t, 12, clave, "La clave debe ser 12")
+			require.Equal(t, "doce", valor, "El valor debe ser 'doce'")
+			iter3.Next()
+
+			clave, valor = iter3.Current()
+			require.Equal(t, 15, clave, "La clave debe ser 15")
+			require.Equal(t, "quince", valor, "El valor debe ser 'quince'")
+			iter3.Next()
+
+			clave, valor = iter3.Current()
+			require.Equal(t, 20, clave, "La clave debe ser 20")
+			require.Equal(t, "veinte", valor, "El valor debe ser 'veinte'")
+			iter3.Next()
+			require.False(t, iter3.HasNext(), "Debe devolver false")
+			require.Panics(t, func() { iter3.Next() })
+			require.Panics(t, func() { iter3.Current() })
+		})
+	}
 }
 
-func TestIteradorInternoEnRangoInexistente(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
+func TestIterarRangoHastaNil(t *testing.T) {
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			// Iterar de 10 hasta nil
+
+			desde4 := 10
+
+			iter4 := dic.IteratorRange(&desde4, nil)
+			require.True(t, iter4.HasNext())
+
+			clave, valor := iter4.Current()
+			require.Equal(t, 10, clave, "La clave debe ser 10")
+			require.Equal(t, "diez", valor, "El valor debe ser 'diez'")
+			iter4.Next()
+
+			clave, valor = iter4.Current()
+			require.Equal(t, 12, clave, "La clave debe ser 12")
+			require.Equal(t, "doce", valor, "El valor debe ser 'doce'")
+			iter4.Next()
+
+			clave, valor = iter4.Current()
+			require.Equal(t, 15, clave, "La clave debe ser 15")
+			require.Equal(t, "quince", valor, "El valor debe ser 'quince'")
+			iter4.Next()
+
+			clave, valor = iter4.Current()
+			require.Equal(t, 20, clave, "La clave debe ser 20")
+			require.Equal(t, "veinte", valor, "El valor debe ser 'veinte'")
+			iter4.Next()
+			require.False(t, iter4.HasNext(), "Debe devolver false")
+			require.Panics(t, func() { iter4.Next() })
+			require.Panics(t, func() { iter4.Current() })
+		})
+	}
+}
 
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
+func TestIterarRangoDesdeNil(t *testing.T) {
+	for name, create := range orderedConstructors[int, string]() {
+		t.Run(name, func(t *testing.T) {
+			dic := create(cmpInt)
+
+			dic.Save(10, "diez")
+			dic.Save(5, "cinco")
+			dic.Save(15, "quince")
+			dic.Save(7, "siete")
+			dic.Save(12, "doce")
+			dic.Save(20, "veinte")
+			dic.Save(3, "tres")
+
+			// Iterar de nil hasta 12
+			hasta5 := 12
+
+			iter5 := dic.IteratorRange(nil, &hasta5)
+			require.True(t, iter5.HasNext(), "Debe devolver true")
+
+			clave, valor := iter5.Current()
+			require.Equal(t, 3, clave, "La clave debe ser 3")
+			require.Equal(t, "tres", valor, "El valor debe ser 'tres'")
+			iter5.Next()
+
+			clave, valor = iter5.Current()
+			require.Equal(t, 5, clave, "La clave debe ser 5")
+			require.Equal(t, "cinco", valor, "El valor debe ser 'cinco'")
+			iter5.Next()
+
+			clave, valor = iter5.Current()
+			require.Equal(t, 7, clave, "La clave debe ser 7")
+			require.Equal(t, "siete", valor, "El valor debe ser 'siete'")
+			iter5.Next()
+
+			clave, valor = iter5.Current()
+			require.Equal(t, 10, clave, "La clave debe ser 10")
+			require.Equal(t, "diez", valor, "El valor debe ser 'diez'")
+			iter5.Next()
+
+			clave, valor = iter5.Current()
+			require.Equal(t, 12, clave, "La clave debe ser 12")
+			require.Equal(t, "doce", valor, "El valor debe ser 'doce'")
+			iter5.Next()
+			require.False(t, iter5.HasNext(), "Debe devolver false")
+			require.Panics(t, func() { iter5.Next() })
+			require.Panics(t, func() { iter5.Current() })
+		})
+	}
+}
 
-	suma := 0
+func TestBSTSortedKeys(t *testing.T) {
+	t.Log("Saves the keys in increasing order, which makes the tree degenerate into a list, and checks that " +
+		"every operation still works without depending on the depth of the tree")
+	for name, create := range orderedConstructors[int, int]() {
+		t.Run(name, func(t *testing.T) {
+			n := 10000
+			dic := create(cmpInt)
+			for i := range n {
+				dic.Save(i, i)
+			}
+			require.EqualValues(t, n, dic.Count())
+			require.True(t, dic.Contains(n-1))
+			require.EqualValues(t, n-1, dic.Get(n-1))
+
+			expected := 0
+			dic.Iterate(func(key int, value int) bool {
+				require.Equal(t, expected, key)
+				expected++
+				return true
+			})
+			require.Equal(t, n, expected)
+
+			from, to := n-10, n-1
+			count := 0
+			for iter := dic.IteratorRange(&from, &to); iter.HasNext(); iter.Next() {
+				count++
+			}
+			require.Equal(t, 10, count)
 
-	// Iterar en un rango sin claves (25-30)
-	desde := 25
-	hasta := 30
+			for i := n - 1; i >= 0; i-- {
+				require.EqualValues(t, i, dic.Remove(i))
+			}
+			require.EqualValues(t, 0, dic.Count())
+			require.False(t, dic.Iterator().HasNext())
+		})
+	}
+}
 
-	visitar3 := func(clave int, valor string) bool {
-		suma += clave
-		return true
+func orderedMapFactories() map[string]func() ADTMap.BSTMap[int, int] {
+	return map[string]func() ADTMap.BSTMap[int, int]{
+		"BST":      func() ADTMap.BSTMap[int, int] { return ADTMap.CreateBST[int, int](cmpInt) },
+		"SkipList": func() ADTMap.BSTMap[int, int] { return ADTMap.CreateSkipListWithSeed[int, int](cmpInt, 1) },
+		"BTree":    func() ADTMap.BSTMap[int, int] { return ADTMap.CreateBTree[int, int](cmpInt, 2) },
+		"Treap":    func() ADTMap.BSTMap[int, int] { return ADTMap.CreateTreapWithSeed[int, int](cmpInt, 1) },
+		"Aggregate": func() ADTMap.BSTMap[int, int] {
+			return ADTMap.CreateAggregateMap(cmpInt, ADTMap.CountMonoid[int, int]())
+		},
 	}
+}
 
-	dic.IterateRange(&desde, &hasta, visitar3)
-	require.Equal(t, 0, suma, "La suma de los elementos de un rango inexistente debe ser 0")
+// bstIterator returns the iterator over the range, which for the ordered maps of this package offers Seek and Delete.
+func bstIterator(t *testing.T, dic ADTMap.BSTMap[int, int], from *int, to *int) ADTMap.BSTMapIterator[int, int] {
+	iter, ok := dic.IteratorRange(from, to).(ADTMap.BSTMapIterator[int, int])
+	require.True(t, ok, "The iterators of the ordered maps should implement BSTMapIterator")
+	return iter
 }
 
-func TestIteradorInternoRecorridoInOrder(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
-
-	// Guardar elementos en el diccionario
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
-
-	// Prueba de recorrido in-order (desde = 5, hasta = nil)
-	desde := 5
-	arr := []int{}
-
-	visitar4 := func(clave int, valor string) bool {
-		arr = append(arr, clave)
-		return true
-	}
+func TestIteratorSeek(t *testing.T) {
+	for name, create := range orderedMapFactories() {
+		t.Run(name, func(t *testing.T) {
+			dic := create()
+			for _, key := range rand.Perm(50) {
+				dic.Save(key*2, key)
+			}
+
+			from, to := 10, 40
+			iter := bstIterator(t, dic, &from, &to)
+			iter.Seek(25)
+			key, _ := iter.Current()
+			require.Equal(t, 26, key, "Seek should move to the first key greater than or equal to the given one")
+
+			iter.Seek(12)
+			key, _ = iter.Current()
+			require.Equal(t, 12, key, "Seek can move the iterator backwards")
 
-	dic.IterateRange(&desde, nil, visitar4)
-	require.Equal(t, []int{5, 7, 10, 12, 15, 20}, arr, "El recorrido in-order debe ser [5, 7, 10, 12, 15, 20]")
+			iter.Seek(0)
+			key, _ = iter.Current()
+			require.Equal(t, from, key, "Seek should not move before the beginning of the range")
+
+			iter.Seek(41)
+			require.False(t, iter.HasNext(), "Seek past the end of the range should finish the iteration")
+			require.Panics(t, func() { iter.Current() })
+		})
+	}
 }
 
-// Pruebas del iterador externo
-func TestIteradorExternoRango(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
-
-	// Guardar elementos en el diccionario
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
-
-	// Iterar desde 5 hasta 12 in-order
-	desde := 5
-	hasta := 12
-
-	iter := dic.IteratorRange(&desde, &hasta)
-	require.True(t, iter.HasNext(), "Debe devolver true")
-
-	clave, valor := iter.Current()
-	require.Equal(t, 5, clave, "La clave debe ser 5")
-	require.Equal(t, "cinco", valor, "El valor debe ser 'cinco'")
-	iter.Next()
-
-	clave, valor = iter.Current()
-	require.Equal(t, 7, clave, "La clave debe ser 7")
-	require.Equal(t, "siete", valor, "El valor debe ser 'siete'")
-	iter.Next()
-
-	clave, valor = iter.Current()
-	require.Equal(t, 10, clave, "La clave debe ser 10")
-	require.Equal(t, "diez", valor, "El valor debe ser 'diez'")
-	iter.Next()
-
-	clave, valor = iter.Current()
-	require.Equal(t, 12, clave, "La clave debe ser 12")
-	require.Equal(t, "doce", valor, "El valor debe ser 'doce'")
-	iter.Next()
-	require.False(t, iter.HasNext(), "Debe devolver false")
-	require.Panics(t, func() { iter.Next() })
-	require.Panics(t, func() { iter.Current() })
+func TestIteratorDelete(t *testing.T) {
+	for name, create := range orderedMapFactories() {
+		t.Run(name, func(t *testing.T) {
+			dic := create()
+			for _, key := range rand.Perm(1000) {
+				dic.Save(key, key)
+			}
+
+			// Delete every odd key while iterating
+			iter := bstIterator(t, dic, nil, nil)
+			expected := 0
+			for iter.HasNext() {
+				key, _ := iter.Current()
+				require.Equal(t, expected, key)
+				if key%2 == 1 {
+					require.Equal(t, key, iter.Delete())
+				} else {
+					iter.Next()
+				}
+				expected++
+			}
+			require.Panics(t, func() { iter.Delete() })
+			require.EqualValues(t, 500, dic.Count())
+
+			expected = 0
+			dic.Iterate(func(key int, _ int) bool {
+				require.Equal(t, expected, key)
+				expected += 2
+				return true
+			})
+
+			// Deleting the last key of a range finishes the iteration
+			from, to := 100, 102
+			iter = bstIterator(t, dic, &from, &to)
+			iter.Delete()
+			key, _ := iter.Current()
+			require.Equal(t, 102, key)
+			iter.Delete()
+			require.False(t, iter.HasNext())
+			require.False(t, dic.Contains(100))
+			require.False(t, dic.Contains(102))
+			require.True(t, dic.Contains(104))
+		})
+	}
 }
 
-func TestIterarRangoSinElementos(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
+func TestBSTFromSorted(t *testing.T) {
+	t.Log("Builds a tree from many sorted keys. If it were built key by key it would degenerate into a list, " +
+		"and looking up every key would take too long")
+	n := 100000
+	keys, values := make([]int, n), make([]string, n)
+	for i := range n {
+		keys[i], values[i] = i, fmt.Sprint(i)
+	}
+	dic, err := ADTMap.BSTFromSorted(keys, values, cmpInt)
+	require.NoError(t, err)
+	require.EqualValues(t, n, dic.Count())
+	for i := range n {
+		require.Equal(t, values[i], dic.Get(i))
+	}
 
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
+	expected := 0
+	dic.Iterate(func(key int, _ string) bool {
+		require.Equal(t, expected, key)
+		expected++
+		return true
+	})
+	require.Equal(t, n, expected)
 
-	// Iterar en un rango sin elementos (25-30)
+	dic.Save(-1, "")
+	require.EqualValues(t, n+1, dic.Count())
+	require.Equal(t, "0", dic.Remove(0))
 
-	desde2 := 25
-	hasta2 := 30
+	empty, err := ADTMap.BSTFromSorted([]int{}, []string{}, cmpInt)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, empty.Count())
+	require.False(t, empty.Iterator().HasNext())
 
-	iter2 := dic.IteratorRange(&desde2, &hasta2)
-	require.False(t, iter2.HasNext(), "Debe devolver false")
-	require.Panics(t, func() { iter2.Next() })
-	require.Panics(t, func() { iter2.Current() })
+	for _, keys := range [][]int{{2, 1}, {1, 1}} {
+		unsorted, err := ADTMap.BSTFromSorted(keys, []string{"", ""}, cmpInt)
+		require.ErrorIs(t, err, ADTMap.ErrNotSorted)
+		require.Nil(t, unsorted)
+	}
+	require.Panics(t, func() { ADTMap.BSTFromSorted([]int{1, 2}, []string{""}, cmpInt) })
 }
 
-func TestIterarRangoSinRango(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
-
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
-
-	iter3 := dic.IteratorRange(nil, nil)
-	require.True(t, iter3.HasNext(), "Debe devolver true")
-
-	clave, valor := iter3.Current()
-	require.Equal(t, 3, clave, "La clave debe ser 3")
-	require.Equal(t, "tres", valor, "El valor debe ser 'tres'")
-	iter3.Next()
-
-	clave, valor = iter3.Current()
-	require.Equal(t, 5, clave, "La clave debe ser 5")
-	require.Equal(t, "cinco", valor, "El valor debe ser 'cinco'")
-	iter3.Next()
-
-	clave, valor = iter3.Current()
-	require.Equal(t, 7, clave, "La clave debe ser 7")
-	require.Equal(t, "siete", valor, "El valor debe ser 'siete'")
-	iter3.Next()
-
-	clave, valor = iter3.Current()
-	require.Equal(t, 10, clave, "La clave debe ser 10")
-	require.Equal(t, "diez", valor, "El valor debe ser 'diez'")
-	iter3.Next()
-
-	clave, valor = iter3.Current()
-	require.Equal(t, 12, clave, "La clave debe ser 12")
-	require.Equal(t, "doce", valor, "El valor debe ser 'doce'")
-	iter3.Next()
-
-	clave, valor = iter3.Current()
-	require.Equal(t, 15, clave, "La clave debe ser 15")
-	require.Equal(t, "quince", valor, "El valor debe ser 'quince'")
-	iter3.Next()
-
-	clave, valor = iter3.Current()
-	require.Equal(t, 20, clave, "La clave debe ser 20")
-	require.Equal(t, "veinte", valor, "El valor debe ser 'veinte'")
-	iter3.Next()
-	require.False(t, iter3.HasNext(), "Debe devolver false")
-	require.Panics(t, func() { iter3.Next() })
-	require.Panics(t, func() { iter3.Current() })
-}
+func TestMergeBST(t *testing.T) {
+	a := ADTMap.CreateBST[int, int](cmpInt)
+	b := ADTMap.CreateSkipListWithSeed[int, int](cmpInt, 1)
+	for _, key := range rand.Perm(100) {
+		a.Save(key*2, 1)
+		b.Save(key*3, 2)
+	}
 
-func TestIterarRangoHastaNil(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
+	sum := func(_ int, valueA int, valueB int) int { return valueA + valueB }
+	merged, err := ADTMap.MergeBST(a, b, sum)
+	require.NoError(t, err)
 
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
+	expected := ADTMap.CreateBST[int, int](cmpInt)
+	a.Iterate(func(key int, value int) bool {
+		expected.Save(key, value)
+		return true
+	})
+	b.Iterate(func(key int, value int) bool {
+		if expected.Contains(key) {
+			expected.Save(key, expected.Get(key)+value)
+		} else {
+			expected.Save(key, value)
+		}
+		return true
+	})
 
-	// Iterar de 10 hasta nil
+	require.Equal(t, expected.Count(), merged.Count())
+	iterExpected, iterMerged := expected.Iterator(), merged.Iterator()
+	for iterExpected.HasNext() {
+		keyExpected, valueExpected := iterExpected.Current()
+		keyMerged, valueMerged := iterMerged.Current()
+		require.Equal(t, keyExpected, keyMerged)
+		require.Equal(t, valueExpected, valueMerged)
+		iterExpected.Next()
+		iterMerged.Next()
+	}
+	require.False(t, iterMerged.HasNext())
+	require.EqualValues(t, 100, a.Count(), "The merged maps should not be modified")
+	require.EqualValues(t, 100, b.Count(), "The merged maps should not be modified")
+	require.Equal(t, 3, merged.Get(0))
+	require.Equal(t, 3, merged.Get(6))
+
+	empty := ADTMap.CreateBST[int, int](cmpInt)
+	merged, err = ADTMap.MergeBST(empty, b, sum)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, merged.Count())
+}
 
-	desde4 := 10
+// plainBSTMap hides the comparator of the map it wraps, as an implementation from outside the package.
+type plainBSTMap struct {
+	ADTMap.BSTMap[int, int]
+}
 
-	iter4 := dic.IteratorRange(&desde4, nil)
-	require.True(t, iter4.HasNext())
+func TestMergeBSTUnknownComparator(t *testing.T) {
+	sum := func(_ int, valueA int, valueB int) int { return valueA + valueB }
+	plain := plainBSTMap{ADTMap.CreateBST[int, int](cmpInt)}
+	for _, a := range []ADTMap.BSTMap[int, int]{plain, ADTMap.NewSynchronizedBST[int, int](plain)} {
+		merged, err := ADTMap.MergeBST(a, ADTMap.CreateBST[int, int](cmpInt), sum)
+		require.ErrorIs(t, err, ADTMap.ErrUnknownComparator)
+		require.Nil(t, merged)
+	}
 
-	clave, valor := iter4.Current()
-	require.Equal(t, 10, clave, "La clave debe ser 10")
-	require.Equal(t, "diez", valor, "El valor debe ser 'diez'")
-	iter4.Next()
+	merged, err := ADTMap.MergeBST(ADTMap.CreateBST[int, int](cmpInt), plain, sum)
+	require.ErrorIs(t, err, ADTMap.ErrUnknownComparator, "The comparator of the second map is needed too")
+	require.Nil(t, merged)
+}
 
-	clave, valor = iter4.Current()
-	require.Equal(t, 12, clave, "La clave debe ser 12")
-	require.Equal(t, "doce", valor, "El valor debe ser 'doce'")
-	iter4.Next()
+func TestMergeBSTComparatorMismatch(t *testing.T) {
+	sum := func(_ int, valueA int, valueB int) int { return valueA + valueB }
+	descending := compare.Reverse(compare.Natural[int]())
+	// Built by the same function literal as descending, so only the order of the keys tells them apart
+	ascending := compare.Reverse(compare.By(func(key int) int { return -key }))
+	for _, cmpB := range []func(int, int) int{cmpInt, ascending} {
+		a, b := ADTMap.CreateBST[int, int](descending), ADTMap.CreateBST[int, int](cmpB)
+		for key := 0; key < 10; key++ {
+			a.Save(key, 1)
+			b.Save(key+5, 2)
+		}
+		merged, err := ADTMap.MergeBST(a, b, sum)
+		require.ErrorIs(t, err, ADTMap.ErrComparatorMismatch)
+		require.Nil(t, merged)
+	}
 
-	clave, valor = iter4.Current()
-	require.Equal(t, 15, clave, "La clave debe ser 15")
-	require.Equal(t, "quince", valor, "El valor debe ser 'quince'")
-	iter4.Next()
+	a, b := ADTMap.CreateBST[int, int](descending), ADTMap.CreateSkipList[int, int](descending)
+	a.Save(1, 1)
+	b.Save(2, 2)
+	merged, err := ADTMap.MergeBST(a, b, sum)
+	require.NoError(t, err, "Maps of different types ordered by the same comparator can be merged")
+	require.EqualValues(t, 2, merged.Count())
+}
 
-	clave, valor = iter4.Current()
-	require.Equal(t, 20, clave, "La clave debe ser 20")
-	require.Equal(t, "veinte", valor, "El valor debe ser 'veinte'")
-	iter4.Next()
-	require.False(t, iter4.HasNext(), "Debe devolver false")
-	require.Panics(t, func() { iter4.Next() })
-	require.Panics(t, func() { iter4.Current() })
+func TestCreateBSTOrdered(t *testing.T) {
+	tree := ADTMap.CreateBSTOrdered[string, int]()
+	for i, word := range []string{"pear", "apple", "quince", "fig"} {
+		tree.Save(word, i)
+	}
+	keys := []string{}
+	tree.Iterate(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, []string{"apple", "fig", "pear", "quince"}, keys)
+	require.Equal(t, 2, tree.Get("quince"))
 }
 
-func TestIterarRangoDesdeNil(t *testing.T) {
-	dic := ADTMap.CreateBST[int, string](cmpInt)
-
-	dic.Save(10, "diez")
-	dic.Save(5, "cinco")
-	dic.Save(15, "quince")
-	dic.Save(7, "siete")
-	dic.Save(12, "doce")
-	dic.Save(20, "veinte")
-	dic.Save(3, "tres")
-
-	// Iterar de nil hasta 12
-	hasta5 := 12
-
-	iter5 := dic.IteratorRange(nil, &hasta5)
-	require.True(t, iter5.HasNext(), "Debe devolver true")
-
-	clave, valor := iter5.Current()
-	require.Equal(t, 3, clave, "La clave debe ser 3")
-	require.Equal(t, "tres", valor, "El valor debe ser 'tres'")
-	iter5.Next()
-
-	clave, valor = iter5.Current()
-	require.Equal(t, 5, clave, "La clave debe ser 5")
-	require.Equal(t, "cinco", valor, "El valor debe ser 'cinco'")
-	iter5.Next()
-
-	clave, valor = iter5.Current()
-	require.Equal(t, 7, clave, "La clave debe ser 7")
-	require.Equal(t, "siete", valor, "El valor debe ser 'siete'")
-	iter5.Next()
-
-	clave, valor = iter5.Current()
-	require.Equal(t, 10, clave, "La clave debe ser 10")
-	require.Equal(t, "diez", valor, "El valor debe ser 'diez'")
-	iter5.Next()
-
-	clave, valor = iter5.Current()
-	require.Equal(t, 12, clave, "La clave debe ser 12")
-	require.Equal(t, "doce", valor, "El valor debe ser 'doce'")
-	iter5.Next()
-	require.False(t, iter5.HasNext(), "Debe devolver false")
-	require.Panics(t, func() { iter5.Next() })
-	require.Panics(t, func() { iter5.Current() })
+// BenchmarkBSTNodeAllocation compares allocating each node on its own against allocating them from an arena,
+// while filling a BST, replacing half of its keys and emptying it.
+func BenchmarkBSTNodeAllocation(b *testing.B) {
+	const size = 100000
+	keys := rand.New(rand.NewSource(1)).Perm(size)
+	factories := map[string]func(cmp func(a, b int) int) ADTMap.BSTMap[int, int]{
+		"Heap":  ADTMap.CreateBST[int, int],
+		"Arena": ADTMap.CreateArenaBST[int, int],
+	}
+	for name, create := range factories {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				tree := create(cmpInt)
+				for _, key := range keys {
+					tree.Save(key, key)
+				}
+				for _, key := range keys[:size/2] {
+					tree.Remove(key)
+					tree.Save(key+size, key)
+				}
+				for _, key := range keys[:size/2] {
+					tree.Remove(key + size)
+				}
+				for _, key := range keys[size/2:] {
+					tree.Remove(key)
+				}
+			}
+		})
+	}
 }
@@ -0,0 +1,300 @@
+package mymap
+
+import (
+	"hash/crc32"
+	"math/bits"
+
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+)
+
+const (
+	_HAMT_BITS = 5
+	_HAMT_MASK = 1<<_HAMT_BITS - 1
+	_HASH_BITS = 32
+)
+
+// ===================== Types ======================
+
+// hamtSlot is either an element, or a link to the subtrie of the elements whose hashes share the bits
+// consumed so far, when child is not nil.
+type hamtSlot[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint32
+	child *hamtNode[K, V]
+}
+
+// hamtNode is a node of a hash array mapped trie. Each level consumes 5 bits of the hash, and bitmap marks
+// which of the 32 possible slots are in use, so that only those are stored, in order. Once the whole hash
+// is consumed, the node lists the elements whose hashes collide, and bitmap is not used.
+type hamtNode[K comparable, V any] struct {
+	bitmap uint32
+	slots  []hamtSlot[K, V]
+}
+
+type persistentHash[K comparable, V any] struct {
+	root  *hamtNode[K, V]
+	count int
+}
+
+type hamtFrame[K comparable, V any] struct {
+	node  *hamtNode[K, V]
+	index int
+}
+
+type persistentHashIterator[K comparable, V any] struct {
+	stack   ADTStack.Stack[*hamtFrame[K, V]]
+	current *hamtSlot[K, V]
+}
+
+// ===================== HAMT Auxiliaries ======================
+
+func hashKey[K comparable](key K) uint32 {
+	return crc32.ChecksumIEEE(toBytes(key))
+}
+
+// position returns the bit of the slot for the hash at the given shift, and the index where that slot is
+// or should be stored.
+func (node *hamtNode[K, V]) position(hash uint32, shift int) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & _HAMT_MASK)
+	return bit, bits.OnesCount32(node.bitmap & (bit - 1))
+}
+
+// withSlot returns a copy of the node with the slot at the given index replaced.
+func (node *hamtNode[K, V]) withSlot(index int, slot hamtSlot[K, V]) *hamtNode[K, V] {
+	slots := make([]hamtSlot[K, V], len(node.slots))
+	copy(slots, node.slots)
+	slots[index] = slot
+	return &hamtNode[K, V]{node.bitmap, slots}
+}
+
+// withInserted returns a copy of the node with the slot inserted at the given index.
+func (node *hamtNode[K, V]) withInserted(bit uint32, index int, slot hamtSlot[K, V]) *hamtNode[K, V] {
+	slots := make([]hamtSlot[K, V], 0, len(node.slots)+1)
+	slots = append(slots, node.slots[:index]...)
+	slots = append(slots, slot)
+	slots = append(slots, node.slots[index:]...)
+	return &hamtNode[K, V]{node.bitmap | bit, slots}
+}
+
+// withRemoved returns a copy of the node without the slot at the given index.
+func (node *hamtNode[K, V]) withRemoved(bit uint32, index int) *hamtNode[K, V] {
+	slots := make([]hamtSlot[K, V], 0, len(node.slots)-1)
+	slots = append(slots, node.slots[:index]...)
+	slots = append(slots, node.slots[index+1:]...)
+	return &hamtNode[K, V]{node.bitmap &^ bit, slots}
+}
+
+func (node *hamtNode[K, V]) find(key K, hash uint32) *hamtSlot[K, V] {
+	for shift := 0; shift < _HASH_BITS; shift += _HAMT_BITS {
+		bit, index := node.position(hash, shift)
+		if node.bitmap&bit == 0 {
+			return nil
+		}
+		slot := &node.slots[index]
+		if slot.child == nil {
+			if slot.key == key {
+				return slot
+			}
+			return nil
+		}
+		node = slot.child
+	}
+	for i := range node.slots {
+		if node.slots[i].key == key {
+			return &node.slots[i]
+		}
+	}
+	return nil
+}
+
+// pair builds the subtrie holding two elements whose hashes share the bits before the given shift.
+func pair[K comparable, V any](first, second hamtSlot[K, V], shift int) *hamtNode[K, V] {
+	if shift >= _HASH_BITS {
+		return &hamtNode[K, V]{slots: []hamtSlot[K, V]{first, second}}
+	}
+	firstIndex := (first.hash >> shift) & _HAMT_MASK
+	secondIndex := (second.hash >> shift) & _HAMT_MASK
+	switch {
+	case firstIndex == secondIndex:
+		child := pair(first, second, shift+_HAMT_BITS)
+		return &hamtNode[K, V]{uint32(1) << firstIndex, []hamtSlot[K, V]{{child: child}}}
+	case firstIndex < secondIndex:
+		return &hamtNode[K, V]{uint32(1)<<firstIndex | uint32(1)<<secondIndex, []hamtSlot[K, V]{first, second}}
+	default:
+		return &hamtNode[K, V]{uint32(1)<<firstIndex | uint32(1)<<secondIndex, []hamtSlot[K, V]{second, first}}
+	}
+}
+
+// save returns a copy of the path to the element with the element stored, and whether it was added.
+func (node *hamtNode[K, V]) save(element hamtSlot[K, V], shift int) (*hamtNode[K, V], bool) {
+	if shift >= _HASH_BITS {
+		for i := range node.slots {
+			if node.slots[i].key == element.key {
+				return node.withSlot(i, element), false
+			}
+		}
+		return node.withInserted(0, len(node.slots), element), true
+	}
+
+	bit, index := node.position(element.hash, shift)
+	if node.bitmap&bit == 0 {
+		return node.withInserted(bit, index, element), true
+	}
+	slot := node.slots[index]
+	switch {
+	case slot.child != nil:
+		child, added := slot.child.save(element, shift+_HAMT_BITS)
+		return node.withSlot(index, hamtSlot[K, V]{child: child}), added
+	case slot.key == element.key:
+		return node.withSlot(index, element), false
+	default:
+		child := pair(slot, element, shift+_HAMT_BITS)
+		return node.withSlot(index, hamtSlot[K, V]{child: child}), true
+	}
+}
+
+// remove returns a copy of the path to the element without it, or nil if the key is not found.
+// A subtrie left with a single element is replaced by that element, so the trie stays as shallow as possible.
+func (node *hamtNode[K, V]) remove(key K, hash uint32, shift int) *hamtNode[K, V] {
+	if shift >= _HASH_BITS {
+		for i := range node.slots {
+			if node.slots[i].key == key {
+				return node.withRemoved(0, i)
+			}
+		}
+		return nil
+	}
+
+	bit, index := node.position(hash, shift)
+	if node.bitmap&bit == 0 {
+		return nil
+	}
+	slot := node.slots[index]
+	if slot.child == nil {
+		if slot.key != key {
+			return nil
+		}
+		return node.withRemoved(bit, index)
+	}
+
+	child := slot.child.remove(key, hash, shift+_HAMT_BITS)
+	switch {
+	case child == nil:
+		return nil
+	case len(child.slots) == 1 && child.slots[0].child == nil:
+		return node.withSlot(index, child.slots[0])
+	default:
+		return node.withSlot(index, hamtSlot[K, V]{child: child})
+	}
+}
+
+// ================= Persistent Hash Primitives ==================
+
+// NewPersistentHash creates an empty persistent map backed by a hash array mapped trie. Save and Remove
+// copy only the path to the changed element, which is at most 8 nodes long.
+func NewPersistentHash[K comparable, V any]() PersistentMap[K, V] {
+	hash := new(persistentHash[K, V])
+	hash.root = new(hamtNode[K, V])
+	return hash
+}
+
+func (hash *persistentHash[K, V]) Save(key K, value V) PersistentMap[K, V] {
+	root, added := hash.root.save(hamtSlot[K, V]{key: key, value: value, hash: hashKey(key)}, 0)
+	version := &persistentHash[K, V]{root, hash.count}
+	if added {
+		version.count++
+	}
+	return version
+}
+
+func (hash *persistentHash[K, V]) Remove(key K) PersistentMap[K, V] {
+	root := hash.root.remove(key, hashKey(key), 0)
+	if root == nil {
+		panic(_PANIC_HASH)
+	}
+	return &persistentHash[K, V]{root, hash.count - 1}
+}
+
+func (hash *persistentHash[K, V]) Contains(key K) bool {
+	return hash.root.find(key, hashKey(key)) != nil
+}
+
+func (hash *persistentHash[K, V]) Get(key K) V {
+	slot := hash.root.find(key, hashKey(key))
+	if slot == nil {
+		panic(_PANIC_HASH)
+	}
+	return slot.value
+}
+
+func (hash *persistentHash[K, V]) TryGet(key K) (V, error) {
+	slot := hash.root.find(key, hashKey(key))
+	if slot == nil {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return slot.value, nil
+}
+
+func (hash *persistentHash[K, V]) Count() int {
+	return hash.count
+}
+
+// =================== Internal Iterator ===================
+
+func (hash *persistentHash[K, V]) Iterate(visit func(key K, value V) bool) {
+	for iter := hash.Iterator(); iter.HasNext(); iter.Next() {
+		if !visit(iter.Current()) {
+			return
+		}
+	}
+}
+
+// =================== External Iterator ===================
+
+func (hash *persistentHash[K, V]) Iterator() MapIterator[K, V] {
+	it := new(persistentHashIterator[K, V])
+	it.stack = ADTStack.NewStack[*hamtFrame[K, V]]()
+	it.stack.Push(&hamtFrame[K, V]{node: hash.root})
+	it.advance()
+	return it
+}
+
+// advance walks the trie in depth, stopping at the next element.
+func (it *persistentHashIterator[K, V]) advance() {
+	it.current = nil
+	for !it.stack.IsEmpty() {
+		frame := it.stack.Peek()
+		if frame.index == len(frame.node.slots) {
+			it.stack.Pop()
+			continue
+		}
+		slot := &frame.node.slots[frame.index]
+		frame.index++
+		if slot.child != nil {
+			it.stack.Push(&hamtFrame[K, V]{node: slot.child})
+			continue
+		}
+		it.current = slot
+		return
+	}
+}
+
+func (it *persistentHashIterator[K, V]) HasNext() bool {
+	return it.current != nil
+}
+
+func (it *persistentHashIterator[K, V]) Current() (K, V) {
+	if !it.HasNext() {
+		panic(_PANIC_ITERATOR)
+	}
+	return it.current.key, it.current.value
+}
+
+func (it *persistentHashIterator[K, V]) Next() {
+	if !it.HasNext() {
+		panic(_PANIC_ITERATOR)
+	}
+	it.advance()
+}
@@ -0,0 +1,51 @@
+package mymap
+
+// MapReader holds the read methods of a Map. Every Map is a MapReader.
+type MapReader[K comparable, V any] interface {
+	// Contains returns true if a key is already present in the map. Otherwise, returns false
+	Contains(key K) bool
+
+	// Get returns the value associated with a key. If the key does not belong to the map, it panics
+	Get(key K) V
+
+	// Count returns the number of elements in the map
+	Count() int
+
+	// Iterate iterates over the map internally, applying the function passed as a parameter to each element
+	Iterate(func(key K, value V) bool)
+
+	// Iterator returns an IterMap to iterate over the map
+	Iterator() MapIterator[K, V]
+}
+
+// PersistentMap is an immutable map. Save and Remove leave the map untouched and return a new version,
+// which shares with it every node that did not change, so old versions can be kept cheaply.
+type PersistentMap[K comparable, V any] interface {
+	MapReader[K, V]
+
+	// Save returns a new version of the map with the key-value pair stored
+	Save(key K, value V) PersistentMap[K, V]
+
+	// Remove returns a new version of the map without the given key. If the key does not belong to the map,
+	// it panics with the message 'The key does not belong to the map'
+	Remove(key K) PersistentMap[K, V]
+}
+
+// PersistentOrderedMap is a PersistentMap that iterates its keys in order, like a BSTMap.
+type PersistentOrderedMap[K comparable, V any] interface {
+	MapReader[K, V]
+
+	// IterateRange iterates only including the elements that are within the indicated range,
+	// including them if they are found
+	IterateRange(from *K, to *K, visit func(key K, value V) bool)
+
+	// IteratorRange creates an IterMap that only iterates over the keys that are within the indicated range
+	IteratorRange(from *K, to *K) MapIterator[K, V]
+
+	// Save returns a new version of the map with the key-value pair stored
+	Save(key K, value V) PersistentOrderedMap[K, V]
+
+	// Remove returns a new version of the map without the given key. If the key does not belong to the map,
+	// it panics with the message 'The key does not belong to the dictionary'
+	Remove(key K) PersistentOrderedMap[K, V]
+}
@@ -0,0 +1,135 @@
+package mymap_test
+
+import (
+	"math/rand"
+	"testing"
+
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Both keys have the same CRC-32 checksum, so they share the whole path of the hash trie
+const (
+	_COLLIDING_KEY_A = 86821
+	_COLLIDING_KEY_B = 14740600
+)
+
+func requireSameElements(t *testing.T, expected map[int]int, dic ADTMap.MapReader[int, int]) {
+	require.EqualValues(t, len(expected), dic.Count())
+	seen := 0
+	for iter := dic.Iterator(); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.Equal(t, expected[key], value)
+		seen++
+	}
+	require.Equal(t, len(expected), seen)
+	for key, value := range expected {
+		require.True(t, dic.Contains(key))
+		require.Equal(t, value, dic.Get(key))
+	}
+}
+
+func TestPersistentHashVersions(t *testing.T) {
+	empty := ADTMap.NewPersistentHash[string, int]()
+	first := empty.Save("a", 1)
+	second := first.Save("b", 2).Save("a", 10)
+	third := second.Remove("b")
+
+	require.EqualValues(t, 0, empty.Count())
+	require.False(t, empty.Contains("a"))
+	require.Equal(t, 1, first.Get("a"))
+	require.False(t, first.Contains("b"))
+	require.Equal(t, 10, second.Get("a"))
+	require.Equal(t, 2, second.Get("b"))
+	require.False(t, third.Contains("b"))
+	require.EqualValues(t, 1, third.Count())
+	require.PanicsWithValue(t, "The key does not belong to the map", func() { third.Remove("b") })
+	require.PanicsWithValue(t, "The key does not belong to the map", func() { empty.Get("a") })
+	require.False(t, empty.Iterator().HasNext())
+}
+
+func TestPersistentHashCollisions(t *testing.T) {
+	first := ADTMap.NewPersistentHash[int, int]().Save(_COLLIDING_KEY_A, 1)
+	second := first.Save(_COLLIDING_KEY_B, 2).Save(1, 3)
+	requireSameElements(t, map[int]int{_COLLIDING_KEY_A: 1, _COLLIDING_KEY_B: 2, 1: 3}, second)
+
+	third := second.Save(_COLLIDING_KEY_B, 20).Remove(_COLLIDING_KEY_A)
+	requireSameElements(t, map[int]int{_COLLIDING_KEY_B: 20, 1: 3}, third)
+	requireSameElements(t, map[int]int{_COLLIDING_KEY_A: 1}, first)
+}
+
+func TestPersistentTreeVersions(t *testing.T) {
+	empty := ADTMap.CreatePersistentTree[int, string](cmpInt)
+	versions := []ADTMap.PersistentOrderedMap[int, string]{empty}
+	for _, key := range []int{5, 3, 8, 1, 4} {
+		versions = append(versions, versions[len(versions)-1].Save(key, "v"))
+	}
+	removed := versions[len(versions)-1].Remove(3)
+
+	for i, version := range versions {
+		require.EqualValues(t, i, version.Count(), "Old versions should not change")
+	}
+	require.False(t, removed.Contains(3))
+	require.True(t, versions[len(versions)-1].Contains(3))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { empty.Remove(3) })
+
+	keys := []int{}
+	from, to := 2, 8
+	removed.IterateRange(&from, &to, func(key int, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, []int{4, 5, 8}, keys)
+}
+
+func TestPersistentMapsVolume(t *testing.T) {
+	factories := map[string]func() ADTMap.MapReader[int, int]{
+		"Hash": func() ADTMap.MapReader[int, int] { return ADTMap.NewPersistentHash[int, int]() },
+		"Tree": func() ADTMap.MapReader[int, int] { return ADTMap.CreatePersistentTree[int, int](cmpInt) },
+	}
+	// save and remove dispatch to the right version type, since Save and Remove return different interfaces
+	save := func(dic ADTMap.MapReader[int, int], key, value int) ADTMap.MapReader[int, int] {
+		if hash, ok := dic.(ADTMap.PersistentMap[int, int]); ok {
+			return hash.Save(key, value)
+		}
+		return dic.(ADTMap.PersistentOrderedMap[int, int]).Save(key, value)
+	}
+	remove := func(dic ADTMap.MapReader[int, int], key int) ADTMap.MapReader[int, int] {
+		if hash, ok := dic.(ADTMap.PersistentMap[int, int]); ok {
+			return hash.Remove(key)
+		}
+		return dic.(ADTMap.PersistentOrderedMap[int, int]).Remove(key)
+	}
+
+	for name, factory := range factories {
+		t.Run(name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(19))
+			versions := []ADTMap.MapReader[int, int]{factory()}
+			models := []map[int]int{{}}
+
+			for i := 0; i < 3000; i++ {
+				key := rng.Intn(500)
+				model := make(map[int]int, len(models[i]))
+				for k, v := range models[i] {
+					model[k] = v
+				}
+				current := versions[i]
+				if _, ok := model[key]; ok && rng.Intn(2) == 0 {
+					current = remove(current, key)
+					delete(model, key)
+				} else {
+					current = save(current, key, i)
+					model[key] = i
+				}
+				versions = append(versions, current)
+				models = append(models, model)
+			}
+
+			for i := 0; i < len(versions); i += 97 {
+				requireSameElements(t, models[i], versions[i])
+			}
+			requireSameElements(t, models[len(models)-1], versions[len(versions)-1])
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package mymap
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/avl"
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+)
+
+// ===================== Types ==========================
+
+// persistentNode is a node of an immutable AVL tree. Nodes are never modified once built, so a new version
+// of the tree rebuilds the path to the change and shares the rest of the nodes with the old one.
+type persistentNode[K comparable, V any] struct {
+	key    K
+	value  V
+	left   *persistentNode[K, V]
+	right  *persistentNode[K, V]
+	height int
+}
+
+type persistentTree[K comparable, V any] struct {
+	root  *persistentNode[K, V]
+	count int
+	cmp   cmpFunc[K]
+	// balancer rebalances the nodes of the tree, copying the ones it rotates. It is shared by all the versions.
+	balancer avl.Balancer[persistentNode[K, V]]
+}
+
+type persistentTreeIterator[K comparable, V any] struct {
+	tree  *persistentTree[K, V]
+	stack ADTStack.Stack[*persistentNode[K, V]]
+	from  *K
+	to    *K
+}
+
+// ===================== AVL Helpers ==========================
+
+func (tree *persistentTree[K, V]) newNode(key K, value V, left, right *persistentNode[K, V]) *persistentNode[K, V] {
+	node := &persistentNode[K, V]{key: key, value: value, left: left, right: right}
+	tree.balancer.Update(node)
+	return node
+}
+
+// balanced builds a node with the given subtrees, whose heights differ by at most 2, rotating them if
+// needed to restore the AVL property.
+func (tree *persistentTree[K, V]) balanced(key K, value V, left, right *persistentNode[K, V]) *persistentNode[K, V] {
+	return tree.balancer.Rebalance(&persistentNode[K, V]{key: key, value: value, left: left, right: right})
+}
+
+// save returns the subtree with the key-value pair stored, and whether the key was added.
+func (tree *persistentTree[K, V]) save(node *persistentNode[K, V], key K, value V) (*persistentNode[K, V], bool) {
+	if node == nil {
+		return tree.newNode(key, value, nil, nil), true
+	}
+	switch comparison := tree.cmp(key, node.key); {
+	case comparison < 0:
+		left, added := tree.save(node.left, key, value)
+		return tree.balanced(node.key, node.value, left, node.right), added
+	case comparison > 0:
+		right, added := tree.save(node.right, key, value)
+		return tree.balanced(node.key, node.value, node.left, right), added
+	default:
+		return tree.newNode(key, value, node.left, node.right), false
+	}
+}
+
+// removeMin returns the minimum node of the subtree, and the subtree without it.
+func (tree *persistentTree[K, V]) removeMin(node *persistentNode[K, V]) (*persistentNode[K, V], *persistentNode[K, V]) {
+	if node.left == nil {
+		return node, node.right
+	}
+	minimum, left := tree.removeMin(node.left)
+	return minimum, tree.balanced(node.key, node.value, left, node.right)
+}
+
+// remove returns the subtree without the key. If the key is not found, it panics.
+func (tree *persistentTree[K, V]) remove(node *persistentNode[K, V], key K) *persistentNode[K, V] {
+	if node == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	switch comparison := tree.cmp(key, node.key); {
+	case comparison < 0:
+		return tree.balanced(node.key, node.value, tree.remove(node.left, key), node.right)
+	case comparison > 0:
+		return tree.balanced(node.key, node.value, node.left, tree.remove(node.right, key))
+	case node.left == nil:
+		return node.right
+	case node.right == nil:
+		return node.left
+	default:
+		successor, right := tree.removeMin(node.right)
+		return tree.balanced(successor.key, successor.value, node.left, right)
+	}
+}
+
+func (tree *persistentTree[K, V]) findNode(key K) *persistentNode[K, V] {
+	node := tree.root
+	for node != nil {
+		switch comparison := tree.cmp(key, node.key); {
+		case comparison < 0:
+			node = node.left
+		case comparison > 0:
+			node = node.right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// pushLeftUntil stacks the node and its left descendants, skipping the subtrees whose keys are all lower
+// than from. The next node to visit in order ends up at the top of the stack.
+func (tree *persistentTree[K, V]) pushLeftUntil(stack ADTStack.Stack[*persistentNode[K, V]], node *persistentNode[K, V], from *K) {
+	for node != nil {
+		if from == nil || tree.cmp(node.key, *from) >= 0 {
+			stack.Push(node)
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+}
+
+// ===================== CreatePersistentTree ==========================
+
+// CreatePersistentTree creates an empty persistent map backed by an AVL tree. Save and Remove rebuild
+// only the path to the changed key, so each new version takes O(log n) time and space.
+func CreatePersistentTree[K comparable, V any](cmpFunc func(K, K) int) PersistentOrderedMap[K, V] {
+	tree := new(persistentTree[K, V])
+	tree.cmp = cmpFunc
+	tree.balancer = avl.Balancer[persistentNode[K, V]]{
+		Children: func(node *persistentNode[K, V]) (**persistentNode[K, V], **persistentNode[K, V]) {
+			return &node.left, &node.right
+		},
+		Height: func(node *persistentNode[K, V]) *int { return &node.height },
+		Copy: func(node *persistentNode[K, V]) *persistentNode[K, V] {
+			copied := *node
+			return &copied
+		},
+	}
+	return tree
+}
+
+// ===================== Save() and Remove() ==========================
+
+func (tree *persistentTree[K, V]) Save(key K, value V) PersistentOrderedMap[K, V] {
+	root, added := tree.save(tree.root, key, value)
+	version := &persistentTree[K, V]{root, tree.count, tree.cmp, tree.balancer}
+	if added {
+		version.count++
+	}
+	return version
+}
+
+func (tree *persistentTree[K, V]) Remove(key K) PersistentOrderedMap[K, V] {
+	return &persistentTree[K, V]{tree.remove(tree.root, key), tree.count - 1, tree.cmp, tree.balancer}
+}
+
+// ===================== Contains(), Get() and Count() ==========================
+
+func (tree *persistentTree[K, V]) Contains(key K) bool {
+	return tree.findNode(key) != nil
+}
+
+func (tree *persistentTree[K, V]) Get(key K) V {
+	node := tree.findNode(key)
+	if node == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	return node.value
+}
+
+func (tree *persistentTree[K, V]) TryGet(key K) (V, error) {
+	node := tree.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return node.value, nil
+}
+
+func (tree *persistentTree[K, V]) Count() int {
+	return tree.count
+}
+
+// =================== Internal Iterator ===================
+
+func (tree *persistentTree[K, V]) Iterate(visit func(key K, value V) bool) {
+	tree.IterateRange(nil, nil, visit)
+}
+
+func (tree *persistentTree[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	for iter := tree.IteratorRange(from, to); iter.HasNext(); iter.Next() {
+		if !visit(iter.Current()) {
+			return
+		}
+	}
+}
+
+// =================== External Iterator ===================
+
+func (tree *persistentTree[K, V]) Iterator() MapIterator[K, V] {
+	return tree.IteratorRange(nil, nil)
+}
+
+func (tree *persistentTree[K, V]) IteratorRange(from *K, to *K) MapIterator[K, V] {
+	iterator := new(persistentTreeIterator[K, V])
+	iterator.tree = tree
+	iterator.stack = ADTStack.NewStack[*persistentNode[K, V]]()
+	iterator.from = from
+	iterator.to = to
+	tree.pushLeftUntil(iterator.stack, tree.root, from)
+	return iterator
+}
+
+func (iterator *persistentTreeIterator[K, V]) HasNext() bool {
+	if iterator.stack.IsEmpty() {
+		return false
+	}
+	return iterator.to == nil || iterator.tree.cmp(iterator.stack.Peek().key, *iterator.to) <= 0
+}
+
+func (iterator *persistentTreeIterator[K, V]) Current() (K, V) {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	node := iterator.stack.Peek()
+	return node.key, node.value
+}
+
+func (iterator *persistentTreeIterator[K, V]) Next() {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	node := iterator.stack.Pop()
+	iterator.tree.pushLeftUntil(iterator.stack, node.right, iterator.from)
+}
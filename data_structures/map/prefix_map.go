@@ -0,0 +1,16 @@
+package mymap
+
+type PrefixMap[V any] interface {
+	Map[string, V]
+
+	// IteratePrefix iterates in lexicographic order only over the keys that start with the given prefix,
+	// applying the function passed as a parameter to each element
+	IteratePrefix(prefix string, visit func(key string, value V) bool)
+
+	// LongestPrefixOf returns the longest key of the Map that is a prefix of the given key, and its value.
+	// If no key of the Map is a prefix of it, the last result is false
+	LongestPrefixOf(key string) (string, V, bool)
+
+	// CountPrefix returns the number of keys of the Map that start with the given prefix
+	CountPrefix(prefix string) int
+}
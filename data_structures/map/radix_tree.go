@@ -0,0 +1,320 @@
+package mymap
+
+import (
+	"strings"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+)
+
+// ===================== Types ==========================
+
+// radixNode is a node of a compressed trie. label is the part of the key on the edge from its parent, and
+// the children are sorted by the first byte of their labels, which no two of them share. count is the number
+// of keys stored in the subtree rooted at the node.
+type radixNode[V any] struct {
+	label    string
+	children []*radixNode[V]
+	value    V
+	hasValue bool
+	count    int
+}
+
+type radixTree[V any] struct {
+	root          *radixNode[V]
+	modifications modifications.Counter
+}
+
+// radixFrame is a node together with the full key that leads to it.
+type radixFrame[V any] struct {
+	node *radixNode[V]
+	key  string
+}
+
+type radixTreeIterator[V any] struct {
+	stack         ADTStack.Stack[radixFrame[V]]
+	current       *radixFrame[V]
+	modifications modifications.Check
+}
+
+// ===================== Radix Tree Helpers ==========================
+
+func commonPrefixLength(a, b string) int {
+	length := 0
+	for length < len(a) && length < len(b) && a[length] == b[length] {
+		length++
+	}
+	return length
+}
+
+// child returns the child whose label starts with the given byte, and its index. If there is none,
+// it returns nil and the index where such a child should be inserted.
+func (node *radixNode[V]) child(first byte) (*radixNode[V], int) {
+	low, high := 0, len(node.children)
+	for low < high {
+		mid := (low + high) / 2
+		if node.children[mid].label[0] < first {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	if low < len(node.children) && node.children[low].label[0] == first {
+		return node.children[low], low
+	}
+	return nil, low
+}
+
+// findPath returns the nodes from the root to the one whose full key is the given key, or nil if there is
+// no such node.
+func (tree *radixTree[V]) findPath(key string) []*radixNode[V] {
+	path := []*radixNode[V]{tree.root}
+	node := tree.root
+	for key != "" {
+		child, _ := node.child(key[0])
+		if child == nil || !strings.HasPrefix(key, child.label) {
+			return nil
+		}
+		key = key[len(child.label):]
+		node = child
+		path = append(path, node)
+	}
+	return path
+}
+
+func (tree *radixTree[V]) findNode(key string) *radixNode[V] {
+	path := tree.findPath(key)
+	if path == nil || !path[len(path)-1].hasValue {
+		return nil
+	}
+	return path[len(path)-1]
+}
+
+// findPrefix returns the highest node whose full key starts with the given prefix, together with that full key.
+func (tree *radixTree[V]) findPrefix(prefix string) (*radixNode[V], string) {
+	node, key := tree.root, ""
+	for len(key) < len(prefix) {
+		rest := prefix[len(key):]
+		child, _ := node.child(rest[0])
+		if child == nil || (!strings.HasPrefix(rest, child.label) && !strings.HasPrefix(child.label, rest)) {
+			return nil, ""
+		}
+		key += child.label
+		node = child
+	}
+	return node, key
+}
+
+// ===================== CreateRadixTree ==========================
+
+// CreateRadixTree creates a map of strings backed by a compressed trie (radix tree), which allows
+// searching keys by their prefixes.
+func CreateRadixTree[V any]() PrefixMap[V] {
+	tree := new(radixTree[V])
+	tree.root = new(radixNode[V])
+	return tree
+}
+
+// ===================== Save() =======================
+
+func (tree *radixTree[V]) Save(key string, value V) {
+	if node := tree.findNode(key); node != nil {
+		node.value = value
+		return
+	}
+
+	tree.modifications.Modified()
+	node := tree.root
+	node.count++
+	for key != "" {
+		child, index := node.child(key[0])
+		if child == nil {
+			leaf := &radixNode[V]{label: key, value: value, hasValue: true, count: 1}
+			node.children = insertAt(node.children, index, leaf)
+			return
+		}
+
+		common := commonPrefixLength(key, child.label)
+		if common < len(child.label) {
+			// The key diverges in the middle of the edge: split it with an intermediate node
+			middle := &radixNode[V]{label: child.label[:common], children: []*radixNode[V]{child}, count: child.count}
+			child.label = child.label[common:]
+			node.children[index] = middle
+			child = middle
+		}
+		child.count++
+		key = key[common:]
+		node = child
+	}
+	node.value = value
+	node.hasValue = true
+}
+
+// ===================== Contains() and Get() ==========================
+
+func (tree *radixTree[V]) Contains(key string) bool {
+	return tree.findNode(key) != nil
+}
+
+func (tree *radixTree[V]) Get(key string) V {
+	node := tree.findNode(key)
+	if node == nil {
+		panic(_PANIC_HASH)
+	}
+	return node.value
+}
+
+func (tree *radixTree[V]) TryGet(key string) (V, error) {
+	node := tree.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return node.value, nil
+}
+
+// ===================== Remove() ==========================
+
+// Remove clears the value of the node and then compresses the path again: a node without value is removed
+// if it has no children, and merged with its child if it has only one.
+func (tree *radixTree[V]) Remove(key string) V {
+	path := tree.findPath(key)
+	if path == nil || !path[len(path)-1].hasValue {
+		panic(_PANIC_HASH)
+	}
+	node := path[len(path)-1]
+	removed := node.value
+	var zero V
+	node.value, node.hasValue = zero, false
+	for _, ancestor := range path {
+		ancestor.count--
+	}
+	tree.modifications.Modified()
+
+	for i := len(path) - 1; i > 0; i-- {
+		current, parent := path[i], path[i-1]
+		if current.hasValue || len(current.children) > 1 {
+			break
+		}
+		_, index := parent.child(current.label[0])
+		if len(current.children) == 0 {
+			parent.children = removeAt(parent.children, index)
+			continue
+		}
+		onlyChild := current.children[0]
+		onlyChild.label = current.label + onlyChild.label
+		parent.children[index] = onlyChild
+		break
+	}
+	return removed
+}
+
+// ===================== Count() ==========================
+
+func (tree *radixTree[V]) Count() int {
+	return tree.root.count
+}
+
+// ===================== Prefix Queries ==========================
+
+func (tree *radixTree[V]) CountPrefix(prefix string) int {
+	node, _ := tree.findPrefix(prefix)
+	if node == nil {
+		return 0
+	}
+	return node.count
+}
+
+func (tree *radixTree[V]) LongestPrefixOf(key string) (string, V, bool) {
+	var (
+		longest string
+		value   V
+		found   = tree.root.hasValue
+	)
+	if found {
+		value = tree.root.value
+	}
+
+	node, length := tree.root, 0
+	for length < len(key) {
+		child, _ := node.child(key[length])
+		if child == nil || !strings.HasPrefix(key[length:], child.label) {
+			break
+		}
+		length += len(child.label)
+		node = child
+		if node.hasValue {
+			longest, value, found = key[:length], node.value, true
+		}
+	}
+	return longest, value, found
+}
+
+// =================== Internal Iterator ===================
+
+func (tree *radixTree[V]) Iterate(visit func(key string, value V) bool) {
+	tree.IteratePrefix("", visit)
+}
+
+func (tree *radixTree[V]) IteratePrefix(prefix string, visit func(key string, value V) bool) {
+	node, key := tree.findPrefix(prefix)
+	if node == nil {
+		return
+	}
+	for iter := newRadixTreeIterator(tree, node, key); iter.HasNext(); iter.Next() {
+		if !visit(iter.Current()) {
+			return
+		}
+	}
+}
+
+// =================== External Iterator ===================
+
+func (tree *radixTree[V]) Iterator() MapIterator[string, V] {
+	return newRadixTreeIterator(tree, tree.root, "")
+}
+
+func newRadixTreeIterator[V any](tree *radixTree[V], node *radixNode[V], key string) *radixTreeIterator[V] {
+	iterator := new(radixTreeIterator[V])
+	iterator.modifications = modifications.NewCheck(&tree.modifications, _CONCURRENT_MODIFICATION)
+	iterator.stack = ADTStack.NewStack[radixFrame[V]]()
+	iterator.stack.Push(radixFrame[V]{node, key})
+	iterator.advance()
+	return iterator
+}
+
+// advance visits the nodes in preorder until finding one with a value. Since the key of a node is a prefix
+// of the keys of its descendants and the children are sorted, the keys are found in lexicographic order.
+func (iterator *radixTreeIterator[V]) advance() {
+	iterator.current = nil
+	for !iterator.stack.IsEmpty() {
+		frame := iterator.stack.Pop()
+		for i := len(frame.node.children) - 1; i >= 0; i-- {
+			child := frame.node.children[i]
+			iterator.stack.Push(radixFrame[V]{child, frame.key + child.label})
+		}
+		if frame.node.hasValue {
+			iterator.current = &frame
+			return
+		}
+	}
+}
+
+func (iterator *radixTreeIterator[V]) HasNext() bool {
+	iterator.modifications.Verify()
+	return iterator.current != nil
+}
+
+func (iterator *radixTreeIterator[V]) Current() (string, V) {
+	if !iterator.HasNext() {
+		panic(_PANIC_ITERATOR)
+	}
+	return iterator.current.key, iterator.current.node.value
+}
+
+func (iterator *radixTreeIterator[V]) Next() {
+	if !iterator.HasNext() {
+		panic(_PANIC_ITERATOR)
+	}
+	iterator.advance()
+}
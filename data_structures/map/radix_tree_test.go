@@ -0,0 +1,154 @@
+package mymap_test
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+var _RESOURCES = []string{
+	"/algoritmos/",
+	"/algoritmos/tp1",
+	"/algoritmos/tp2",
+	"/algoritmos/tp2/enunciado",
+	"/algebra",
+	"/",
+	"/favicon.ico",
+}
+
+func prefixKeys(tree ADTMap.PrefixMap[int], prefix string) []string {
+	keys := []string{}
+	tree.IteratePrefix(prefix, func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func TestRadixTreeEmpty(t *testing.T) {
+	tree := ADTMap.CreateRadixTree[int]()
+	require.EqualValues(t, 0, tree.Count())
+	require.False(t, tree.Contains(""))
+	require.Panics(t, func() { tree.Get("") })
+	require.Panics(t, func() { tree.Remove("a") })
+	require.EqualValues(t, 0, tree.CountPrefix(""))
+	_, _, found := tree.LongestPrefixOf("abc")
+	require.False(t, found)
+
+	iter := tree.Iterator()
+	require.False(t, iter.HasNext())
+	require.Panics(t, func() { iter.Current() })
+	require.Panics(t, func() { iter.Next() })
+}
+
+func TestRadixTreeSaveAndGet(t *testing.T) {
+	tree := ADTMap.CreateRadixTree[int]()
+	for i, resource := range _RESOURCES {
+		tree.Save(resource, i)
+	}
+	require.EqualValues(t, len(_RESOURCES), tree.Count())
+	for i, resource := range _RESOURCES {
+		require.True(t, tree.Contains(resource))
+		require.Equal(t, i, tree.Get(resource))
+	}
+	require.False(t, tree.Contains("/algoritmos"), "A prefix of a key should not belong to the map")
+	require.False(t, tree.Contains("/algoritmos/tp3"))
+
+	tree.Save("/algoritmos/tp1", 100)
+	require.EqualValues(t, len(_RESOURCES), tree.Count())
+	require.Equal(t, 100, tree.Get("/algoritmos/tp1"))
+
+	tree.Save("", -1)
+	require.True(t, tree.Contains(""), "The empty string is a valid key")
+	require.Equal(t, -1, tree.Remove(""))
+}
+
+func TestRadixTreePrefixes(t *testing.T) {
+	tree := ADTMap.CreateRadixTree[int]()
+	for i, resource := range _RESOURCES {
+		tree.Save(resource, i)
+	}
+
+	require.Equal(t, []string{"/algoritmos/", "/algoritmos/tp1", "/algoritmos/tp2", "/algoritmos/tp2/enunciado"},
+		prefixKeys(tree, "/algoritmos/"))
+	require.Equal(t, []string{"/algebra", "/algoritmos/", "/algoritmos/tp1", "/algoritmos/tp2",
+		"/algoritmos/tp2/enunciado"}, prefixKeys(tree, "/alg"))
+	require.Empty(t, prefixKeys(tree, "/algoritmos/tp3"))
+	require.EqualValues(t, 4, tree.CountPrefix("/algoritmos"))
+	require.EqualValues(t, 2, tree.CountPrefix("/algoritmos/tp2"))
+	require.EqualValues(t, len(_RESOURCES), tree.CountPrefix(""))
+	require.EqualValues(t, 0, tree.CountPrefix("/x"))
+
+	key, value, found := tree.LongestPrefixOf("/algoritmos/tp2/solucion")
+	require.True(t, found)
+	require.Equal(t, "/algoritmos/tp2", key)
+	require.Equal(t, 2, value)
+
+	key, _, found = tree.LongestPrefixOf("/algoritmos")
+	require.True(t, found)
+	require.Equal(t, "/", key)
+
+	_, _, found = tree.LongestPrefixOf("algoritmos")
+	require.False(t, found)
+}
+
+func TestRadixTreeVolume(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	tree := ADTMap.CreateRadixTree[int]()
+	stored := make(map[string]int)
+	alphabet := "ab/"
+
+	randomKey := func() string {
+		var builder strings.Builder
+		for range rng.Intn(8) {
+			builder.WriteByte(alphabet[rng.Intn(len(alphabet))])
+		}
+		return builder.String()
+	}
+
+	for i := 0; i < 20000; i++ {
+		key := randomKey()
+		if _, ok := stored[key]; ok && rng.Intn(2) == 0 {
+			require.Equal(t, stored[key], tree.Remove(key))
+			delete(stored, key)
+		} else {
+			tree.Save(key, i)
+			stored[key] = i
+		}
+		require.EqualValues(t, len(stored), tree.Count())
+	}
+
+	for range 200 {
+		prefix := randomKey()
+		prefix = prefix[:min(len(prefix), rng.Intn(4))]
+		expected := []string{}
+		for key := range stored {
+			if strings.HasPrefix(key, prefix) {
+				expected = append(expected, key)
+			}
+		}
+		sort.Strings(expected)
+		require.Equal(t, expected, prefixKeys(tree, prefix))
+		require.EqualValues(t, len(expected), tree.CountPrefix(prefix))
+	}
+
+	keys := []string{}
+	for iter := tree.Iterator(); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.Equal(t, stored[key], value)
+		keys = append(keys, key)
+	}
+	require.True(t, sort.StringsAreSorted(keys), "The keys should be iterated in lexicographic order")
+	require.Len(t, keys, len(stored))
+
+	for key := range stored {
+		tree.Remove(key)
+	}
+	require.EqualValues(t, 0, tree.Count())
+	require.False(t, tree.Iterator().HasNext())
+}
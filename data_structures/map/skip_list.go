@@ -0,0 +1,265 @@
+package mymap
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+)
+
+const (
+	_SKIP_LIST_MAX_LEVEL   = 32
+	_SKIP_LIST_PROBABILITY = 0.5
+)
+
+// ===================== Types ==========================
+
+type skipListNode[K comparable, V any] struct {
+	key   K
+	value V
+	next  []*skipListNode[K, V]
+}
+
+type skipList[K comparable, V any] struct {
+	head          *skipListNode[K, V]
+	level         int
+	size          int
+	cmp           cmpFunc[K]
+	rng           *rand.Rand
+	modifications modifications.Counter
+}
+
+type skipListIter[K comparable, V any] struct {
+	current       *skipListNode[K, V]
+	from          *K
+	to            *K
+	list          *skipList[K, V]
+	modifications modifications.Check
+}
+
+// ===================== Skip List Helpers ==========================
+
+func createSkipListNode[K comparable, V any](key K, value V, level int) *skipListNode[K, V] {
+	node := new(skipListNode[K, V])
+	node.key = key
+	node.value = value
+	node.next = make([]*skipListNode[K, V], level)
+	return node
+}
+
+// randomLevel returns the level of a new node: each extra level is added with probability
+// _SKIP_LIST_PROBABILITY, up to _SKIP_LIST_MAX_LEVEL.
+func (list *skipList[K, V]) randomLevel() int {
+	level := 1
+	for level < _SKIP_LIST_MAX_LEVEL && list.rng.Float64() < _SKIP_LIST_PROBABILITY {
+		level++
+	}
+	return level
+}
+
+// findPredecessors returns, for every level, the last node whose key is strictly less than the given key.
+// Levels above list.level point to the head.
+func (list *skipList[K, V]) findPredecessors(key K) []*skipListNode[K, V] {
+	update := make([]*skipListNode[K, V], _SKIP_LIST_MAX_LEVEL)
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && list.cmp(node.next[i].key, key) < 0 {
+			node = node.next[i]
+		}
+		update[i] = node
+	}
+	for i := list.level; i < _SKIP_LIST_MAX_LEVEL; i++ {
+		update[i] = list.head
+	}
+	return update
+}
+
+// lowerBound returns the first node whose key is greater than or equal to the given key,
+// or nil if there is none.
+func (list *skipList[K, V]) lowerBound(key K) *skipListNode[K, V] {
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && list.cmp(node.next[i].key, key) < 0 {
+			node = node.next[i]
+		}
+	}
+	return node.next[0]
+}
+
+func (list *skipList[K, V]) findNode(key K) *skipListNode[K, V] {
+	node := list.lowerBound(key)
+	if node != nil && list.cmp(node.key, key) == 0 {
+		return node
+	}
+	return nil
+}
+
+// ===================== CreateSkipList ==========================
+
+// CreateSkipList creates an ordered map backed by a probabilistic skip list, seeded with the current time.
+func CreateSkipList[K comparable, V any](cmpFunc func(K, K) int) BSTMap[K, V] {
+	return CreateSkipListWithSeed[K, V](cmpFunc, time.Now().UnixNano())
+}
+
+// CreateSkipListWithSeed creates an ordered map backed by a skip list whose node levels are drawn from
+// a random source initialized with the given seed, so that its shape is reproducible.
+func CreateSkipListWithSeed[K comparable, V any](cmpFunc func(K, K) int, seed int64) BSTMap[K, V] {
+	list := new(skipList[K, V])
+	list.head = new(skipListNode[K, V])
+	list.head.next = make([]*skipListNode[K, V], _SKIP_LIST_MAX_LEVEL)
+	list.level = 1
+	list.cmp = cmpFunc
+	list.rng = rand.New(rand.NewSource(seed))
+	return list
+}
+
+// ===================== Save() =======================
+
+func (list *skipList[K, V]) Save(key K, value V) {
+	update := list.findPredecessors(key)
+	if next := update[0].next[0]; next != nil && list.cmp(next.key, key) == 0 {
+		next.value = value
+		return
+	}
+
+	level := list.randomLevel()
+	if level > list.level {
+		list.level = level
+	}
+	node := createSkipListNode(key, value, level)
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+	list.size++
+	list.modifications.Modified()
+}
+
+// ===================== Contains() ==========================
+
+func (list *skipList[K, V]) Contains(key K) bool {
+	return list.findNode(key) != nil
+}
+
+// ===================== Get() ==========================
+
+func (list *skipList[K, V]) Get(key K) V {
+	node := list.findNode(key)
+	if node == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	return node.value
+}
+
+func (list *skipList[K, V]) TryGet(key K) (V, error) {
+	node := list.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return node.value, nil
+}
+
+// ===================== Remove() ==========================
+
+func (list *skipList[K, V]) Remove(key K) V {
+	update := list.findPredecessors(key)
+	node := update[0].next[0]
+	if node == nil || list.cmp(node.key, key) != 0 {
+		panic(_KEY_NOT_FOUND)
+	}
+
+	for i := 0; i < len(node.next); i++ {
+		update[i].next[i] = node.next[i]
+	}
+	for list.level > 1 && list.head.next[list.level-1] == nil {
+		list.level--
+	}
+	list.size--
+	list.modifications.Modified()
+	return node.value
+}
+
+// ===================== Count() ==========================
+
+func (list *skipList[K, V]) Count() int {
+	return list.size
+}
+
+func (list *skipList[K, V]) comparator() cmpFunc[K] {
+	return list.cmp
+}
+
+// =================== Internal Iterator ===================
+
+func (list *skipList[K, V]) Iterate(visit func(key K, value V) bool) {
+	list.IterateRange(nil, nil, visit)
+}
+
+func (list *skipList[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	node := list.head.next[0]
+	if from != nil {
+		node = list.lowerBound(*from)
+	}
+	for node != nil && (to == nil || list.cmp(node.key, *to) <= 0) {
+		if !visit(node.key, node.value) {
+			return
+		}
+		node = node.next[0]
+	}
+}
+
+// =================== External Iterator ===================
+
+func (list *skipList[K, V]) Iterator() MapIterator[K, V] {
+	return list.IteratorRange(nil, nil)
+}
+
+func (list *skipList[K, V]) IteratorRange(from *K, to *K) MapIterator[K, V] {
+	iterator := new(skipListIter[K, V])
+	iterator.list = list
+	iterator.modifications = modifications.NewCheck(&list.modifications, _CONCURRENT_MODIFICATION)
+	iterator.from = from
+	iterator.to = to
+	iterator.current = list.head.next[0]
+	if from != nil {
+		iterator.current = list.lowerBound(*from)
+	}
+	return iterator
+}
+
+func (iterator *skipListIter[K, V]) HasNext() bool {
+	iterator.modifications.Verify()
+	return iterator.current != nil && (iterator.to == nil || iterator.list.cmp(iterator.current.key, *iterator.to) <= 0)
+}
+
+func (iterator *skipListIter[K, V]) Current() (K, V) {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	return iterator.current.key, iterator.current.value
+}
+
+func (iterator *skipListIter[K, V]) Next() {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	iterator.current = iterator.current.next[0]
+}
+
+func (iterator *skipListIter[K, V]) Seek(key K) {
+	iterator.modifications.Verify()
+	iterator.current = iterator.list.lowerBound(*seekStart(key, iterator.from, iterator.list.cmp))
+}
+
+// Delete unlinks the current node. Its own pointers are left untouched, so its successor is still reachable.
+func (iterator *skipListIter[K, V]) Delete() V {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	next := iterator.current.next[0]
+	removed := iterator.list.Remove(iterator.current.key)
+	iterator.modifications.Sync()
+	iterator.current = next
+	return removed
+}
@@ -0,0 +1,321 @@
+package mymap
+
+import (
+	"sync"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+)
+
+// SynchronizedMap is a Map that can be shared between goroutines. Each of its operations is atomic, and so are
+// the compound operations it adds, which would otherwise need a lock held across several calls.
+//
+// Iterate visits a snapshot of the elements taken when it is called, so visit may use the map and other
+// goroutines are not blocked while it runs. An iterator, on the other hand, walks the map itself: it fails fast
+// like the iterators of the wrapped map, and it must not be shared between goroutines
+type SynchronizedMap[K comparable, V any] interface {
+	Map[K, V]
+
+	// GetOrSave returns the value associated with a key and true if the key belongs to the Map. Otherwise, it
+	// saves the given value and returns it and false
+	GetOrSave(key K, value V) (V, bool)
+
+	// Update runs update with exclusive access to the wrapped Map, so that the operations it performs on it are
+	// atomic. The Map it receives must not be kept after update returns
+	Update(update func(Map[K, V]))
+}
+
+// SynchronizedBSTMap is the SynchronizedMap of a BSTMap. IterateRange also visits a snapshot of the elements
+// within the range
+type SynchronizedBSTMap[K comparable, V any] interface {
+	BSTMap[K, V]
+
+	// GetOrSave returns the value associated with a key and true if the key belongs to the Map. Otherwise, it
+	// saves the given value and returns it and false
+	GetOrSave(key K, value V) (V, bool)
+
+	// Update runs update with exclusive access to the wrapped BSTMap, so that the operations it performs on it
+	// are atomic. The BSTMap it receives must not be kept after update returns
+	Update(update func(BSTMap[K, V]))
+}
+
+// ===================== Types ==========================
+
+// synchronized holds the operations shared by both synchronized maps.
+type synchronized[K comparable, V any] struct {
+	lock sync.RWMutex
+	dic  Map[K, V]
+	// exclusiveReads is set for the maps whose reads modify them, which must then take the exclusive lock
+	exclusiveReads bool
+}
+
+type synchronizedMap[K comparable, V any] struct {
+	*synchronized[K, V]
+}
+
+type synchronizedBSTMap[K comparable, V any] struct {
+	*synchronized[K, V]
+	tree BSTMap[K, V]
+}
+
+type synchronizedIter[K comparable, V any] struct {
+	dic  *synchronized[K, V]
+	iter MapIterator[K, V]
+}
+
+type synchronizedBSTIter[K comparable, V any] struct {
+	synchronizedIter[K, V]
+	iter BSTMapIterator[K, V]
+}
+
+// ===================== NewSynchronized ==========================
+
+func newSynchronized[K comparable, V any](dic Map[K, V]) *synchronized[K, V] {
+	wrapper := &synchronized[K, V]{dic: dic}
+	if hash, ok := dic.(*linkedHash[K, V]); ok {
+		// Getting a key moves it to the end of a map in access order
+		wrapper.exclusiveReads = hash.accessOrder
+	}
+	return wrapper
+}
+
+// NewSynchronized wraps the Map in a SynchronizedMap, which guards it with a read-write lock. The Map must not
+// be used directly afterwards
+func NewSynchronized[K comparable, V any](dic Map[K, V]) SynchronizedMap[K, V] {
+	return &synchronizedMap[K, V]{newSynchronized(dic)}
+}
+
+// NewSynchronizedBST wraps the BSTMap in a SynchronizedBSTMap, which guards it with a read-write lock. The
+// BSTMap must not be used directly afterwards
+func NewSynchronizedBST[K comparable, V any](tree BSTMap[K, V]) SynchronizedBSTMap[K, V] {
+	return &synchronizedBSTMap[K, V]{newSynchronized[K, V](tree), tree}
+}
+
+// ===================== Locks ==========================
+
+func (synchronized *synchronized[K, V]) readLock() {
+	if synchronized.exclusiveReads {
+		synchronized.lock.Lock()
+	} else {
+		synchronized.lock.RLock()
+	}
+}
+
+func (synchronized *synchronized[K, V]) readUnlock() {
+	if synchronized.exclusiveReads {
+		synchronized.lock.Unlock()
+	} else {
+		synchronized.lock.RUnlock()
+	}
+}
+
+// ===================== Map ==========================
+
+func (synchronized *synchronized[K, V]) Save(key K, value V) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	synchronized.dic.Save(key, value)
+}
+
+func (synchronized *synchronized[K, V]) Contains(key K) bool {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.dic.Contains(key)
+}
+
+func (synchronized *synchronized[K, V]) Get(key K) V {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.dic.Get(key)
+}
+
+func (synchronized *synchronized[K, V]) TryGet(key K) (V, error) {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return TryGet(synchronized.dic, key)
+}
+
+func (synchronized *synchronized[K, V]) Remove(key K) V {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	return synchronized.dic.Remove(key)
+}
+
+func (synchronized *synchronized[K, V]) TryRemove(key K) (V, error) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	return TryRemove(synchronized.dic, key)
+}
+
+func (synchronized *synchronized[K, V]) Count() int {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.dic.Count()
+}
+
+func (synchronized *synchronized[K, V]) GetOrSave(key K, value V) (V, bool) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	if current, err := TryGet(synchronized.dic, key); err == nil {
+		return current, true
+	}
+	synchronized.dic.Save(key, value)
+	return value, false
+}
+
+func (dic *synchronizedMap[K, V]) Update(update func(Map[K, V])) {
+	dic.lock.Lock()
+	defer dic.lock.Unlock()
+	update(dic.dic)
+}
+
+func (tree *synchronizedBSTMap[K, V]) Update(update func(BSTMap[K, V])) {
+	tree.lock.Lock()
+	defer tree.lock.Unlock()
+	update(tree.tree)
+}
+
+// Validate checks the invariants of the wrapped map, if it has any.
+func (synchronized *synchronized[K, V]) Validate() error {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return debug.Validate(synchronized.dic)
+}
+
+// comparator forwards the comparator of the wrapped tree, so that MergeBST accepts the wrapper too. It is nil
+// if the wrapped tree was not created by this package.
+func (tree *synchronizedBSTMap[K, V]) comparator() cmpFunc[K] {
+	tree.readLock()
+	defer tree.readUnlock()
+	if orderedTree, ok := tree.tree.(ordered[K]); ok {
+		return orderedTree.comparator()
+	}
+	return nil
+}
+
+// =================== Internal Iterator ===================
+
+// visitSnapshot copies the elements visited by iterate while holding the read lock, and then visits the copies.
+func (synchronized *synchronized[K, V]) visitSnapshot(iterate func(visit func(K, V) bool), visit func(K, V) bool) {
+	synchronized.readLock()
+	var pairs []codec.Pair[K, V]
+	iterate(func(key K, value V) bool {
+		pairs = append(pairs, codec.Pair[K, V]{Key: key, Value: value})
+		return true
+	})
+	synchronized.readUnlock()
+	for _, pair := range pairs {
+		if !visit(pair.Key, pair.Value) {
+			return
+		}
+	}
+}
+
+func (synchronized *synchronized[K, V]) Iterate(visit func(key K, value V) bool) {
+	synchronized.visitSnapshot(synchronized.dic.Iterate, visit)
+}
+
+func (tree *synchronizedBSTMap[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	tree.visitSnapshot(func(visit func(K, V) bool) { tree.tree.IterateRange(from, to, visit) }, visit)
+}
+
+// =================== External Iterator ===================
+
+func (synchronized *synchronized[K, V]) Iterator() MapIterator[K, V] {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return &synchronizedIter[K, V]{synchronized, synchronized.dic.Iterator()}
+}
+
+func (tree *synchronizedBSTMap[K, V]) IteratorRange(from *K, to *K) MapIterator[K, V] {
+	tree.readLock()
+	defer tree.readUnlock()
+	iter := tree.tree.IteratorRange(from, to)
+	// The wrapper offers Seek and Delete only if the wrapped iterator does
+	if bstIter, ok := iter.(BSTMapIterator[K, V]); ok {
+		return &synchronizedBSTIter[K, V]{synchronizedIter[K, V]{tree.synchronized, iter}, bstIter}
+	}
+	return &synchronizedIter[K, V]{tree.synchronized, iter}
+}
+
+func (iter *synchronizedIter[K, V]) HasNext() bool {
+	iter.dic.readLock()
+	defer iter.dic.readUnlock()
+	return iter.iter.HasNext()
+}
+
+func (iter *synchronizedIter[K, V]) Current() (K, V) {
+	iter.dic.readLock()
+	defer iter.dic.readUnlock()
+	return iter.iter.Current()
+}
+
+// Next only moves the iterator, which is not shared, so it does not need the exclusive lock.
+func (iter *synchronizedIter[K, V]) Next() {
+	iter.dic.readLock()
+	defer iter.dic.readUnlock()
+	iter.iter.Next()
+}
+
+func (iter *synchronizedBSTIter[K, V]) Seek(key K) {
+	iter.dic.readLock()
+	defer iter.dic.readUnlock()
+	iter.iter.Seek(key)
+}
+
+func (iter *synchronizedBSTIter[K, V]) Delete() V {
+	iter.dic.lock.Lock()
+	defer iter.dic.lock.Unlock()
+	return iter.iter.Delete()
+}
+
+// ===================== Encoding ==========================
+
+// serializable returns the wrapped map, or codec.ErrNotSerializable if it does not implement
+// codec.Serializable.
+func (synchronized *synchronized[K, V]) serializable() (codec.Serializable, error) {
+	if serializable, ok := synchronized.dic.(codec.Serializable); ok {
+		return serializable, nil
+	}
+	return nil, codec.ErrNotSerializable
+}
+
+func (synchronized *synchronized[K, V]) MarshalBinary() ([]byte, error) {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return nil, err
+	}
+	return serializable.MarshalBinary()
+}
+
+func (synchronized *synchronized[K, V]) UnmarshalBinary(data []byte) error {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return err
+	}
+	return serializable.UnmarshalBinary(data)
+}
+
+func (synchronized *synchronized[K, V]) MarshalJSON() ([]byte, error) {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return nil, err
+	}
+	return serializable.MarshalJSON()
+}
+
+func (synchronized *synchronized[K, V]) UnmarshalJSON(data []byte) error {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return err
+	}
+	return serializable.UnmarshalJSON(data)
+}
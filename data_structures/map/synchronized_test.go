@@ -0,0 +1,138 @@
+package mymap_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+	"github.com/sebagarciad/algorithms-and-data-structures/map/maptest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSynchronizedMapsConformance(t *testing.T) {
+	t.Run("Hash", func(t *testing.T) {
+		maptest.RunConformance(t, func() ADTMap.Map[int, int] {
+			return ADTMap.NewSynchronized(ADTMap.NewHash[int, int]())
+		})
+	})
+	t.Run("BST", func(t *testing.T) {
+		maptest.RunOrderedConformance(t, func(cmp func(a, b int) int) ADTMap.BSTMap[int, int] {
+			return ADTMap.NewSynchronizedBST(ADTMap.CreateBST[int, int](cmp))
+		})
+	})
+}
+
+func TestSynchronizedMapGetOrSave(t *testing.T) {
+	const goroutines, keys = 8, 200
+	dic := ADTMap.NewSynchronized(ADTMap.NewHash[int, int]())
+	var saved atomic.Int64
+	var group sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		group.Add(1)
+		go func(g int) {
+			defer group.Done()
+			for key := 0; key < keys; key++ {
+				value, found := dic.GetOrSave(key, g)
+				if !found {
+					saved.Add(1)
+					require.Equal(t, g, value)
+				}
+			}
+		}(g)
+	}
+	group.Wait()
+
+	require.EqualValues(t, keys, saved.Load(), "Each key should be saved by a single goroutine")
+	require.Equal(t, keys, dic.Count())
+	require.NoError(t, debug.Validate(dic))
+}
+
+func TestSynchronizedMapIteratesSnapshot(t *testing.T) {
+	tree := ADTMap.NewSynchronizedBST(ADTMap.CreateBST[int, int](cmpInt))
+	for i := 0; i < 5; i++ {
+		tree.Save(i, i)
+	}
+	visited := []int{}
+	tree.Iterate(func(key, value int) bool {
+		visited = append(visited, key)
+		tree.Remove(key)
+		return true
+	})
+	require.Equal(t, []int{0, 1, 2, 3, 4}, visited, "Iterate should visit the elements there were when it was called")
+	require.Zero(t, tree.Count())
+
+	for i := 0; i < 5; i++ {
+		tree.Save(i, i)
+	}
+	from, to := 1, 3
+	visited = visited[:0]
+	tree.IterateRange(&from, &to, func(key, value int) bool {
+		visited = append(visited, key)
+		tree.Save(key+10, value)
+		return true
+	})
+	require.Equal(t, []int{1, 2, 3}, visited)
+	require.Equal(t, 8, tree.Count())
+}
+
+func TestSynchronizedMapConcurrentUse(t *testing.T) {
+	const goroutines, keys = 8, 500
+	factories := map[string]func() ADTMap.Map[int, int]{
+		"Hash": func() ADTMap.Map[int, int] {
+			return ADTMap.NewSynchronized(ADTMap.NewHash[int, int]())
+		},
+		"LinkedHashAccessOrder": func() ADTMap.Map[int, int] {
+			return ADTMap.NewSynchronized(ADTMap.NewLinkedHashAccessOrder[int, int]())
+		},
+		"BST": func() ADTMap.Map[int, int] {
+			return ADTMap.NewSynchronizedBST(ADTMap.CreateBST[int, int](cmpInt))
+		},
+	}
+	for name, factory := range factories {
+		t.Run(name, func(t *testing.T) {
+			dic := factory()
+			var group sync.WaitGroup
+			for g := 0; g < goroutines; g++ {
+				group.Add(1)
+				go func(g int) {
+					defer group.Done()
+					for i := 0; i < keys; i++ {
+						key := g*keys + i
+						dic.Save(key, i)
+						require.Equal(t, i, dic.Get(key))
+						if i%2 == 1 {
+							require.Equal(t, i, dic.Remove(key))
+						}
+					}
+				}(g)
+			}
+			group.Wait()
+
+			require.Equal(t, goroutines*keys/2, dic.Count())
+			require.NoError(t, debug.Validate(dic))
+		})
+	}
+}
+
+func TestSynchronizedBSTMapMerge(t *testing.T) {
+	descending := func(a, b int) int { return b - a }
+	a := ADTMap.NewSynchronizedBST(ADTMap.CreateBST[int, int](descending))
+	b := ADTMap.NewSynchronizedBST(ADTMap.CreateBST[int, int](descending))
+	for key := 0; key < 10; key++ {
+		a.Save(key, 1)
+		b.Save(key+5, 2)
+	}
+
+	merged, err := ADTMap.MergeBST[int, int](a, b, func(_ int, valueA int, valueB int) int { return valueA + valueB })
+	require.NoError(t, err)
+	keys := []int{}
+	merged.Iterate(func(key int, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, []int{14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, keys, "The comparator of the wrapped tree should be used")
+	require.Equal(t, 3, merged.Get(7))
+}
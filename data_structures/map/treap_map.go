@@ -0,0 +1,394 @@
+package mymap
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/internal/modifications"
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+)
+
+const (
+	_JOIN_ORDER  = "The keys of the joined map must be greater than the keys of the map"
+	_JOIN_ITSELF = "A map cannot be joined with itself"
+)
+
+// ===================== Types ==========================
+
+// treapNode is a BST node by key and a max-heap node by priority. size is the number of nodes
+// of the subtree rooted at the node.
+type treapNode[K comparable, V any] struct {
+	left     *treapNode[K, V]
+	right    *treapNode[K, V]
+	key      K
+	value    V
+	priority uint64
+	size     int
+}
+
+type treapMap[K comparable, V any] struct {
+	root          *treapNode[K, V]
+	cmp           cmpFunc[K]
+	rng           *rand.Rand
+	modifications modifications.Counter
+}
+
+type treapIter[K comparable, V any] struct {
+	stack         ADTStack.Stack[*treapNode[K, V]]
+	from          *K
+	to            *K
+	treap         *treapMap[K, V]
+	modifications modifications.Check
+}
+
+// ===================== Treap Helpers ==========================
+
+func (treap *treapMap[K, V]) createNode(key K, value V) *treapNode[K, V] {
+	node := new(treapNode[K, V])
+	node.key = key
+	node.value = value
+	node.priority = treap.rng.Uint64()
+	node.size = 1
+	return node
+}
+
+func (node *treapNode[K, V]) count() int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func (node *treapNode[K, V]) update() {
+	node.size = 1 + node.left.count() + node.right.count()
+}
+
+func (treap *treapMap[K, V]) findNode(key K) *treapNode[K, V] {
+	node := treap.root
+	for node != nil {
+		compare := treap.cmp(node.key, key)
+		if compare == 0 {
+			return node
+		}
+		if compare > 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return nil
+}
+
+// split divides the subtree into the nodes whose keys are less than the given key and the rest.
+// If inclusive is true, the key itself goes to the left part.
+func split[K comparable, V any](node *treapNode[K, V], key K, inclusive bool, cmp cmpFunc[K]) (*treapNode[K, V], *treapNode[K, V]) {
+	if node == nil {
+		return nil, nil
+	}
+	compare := cmp(node.key, key)
+	if compare < 0 || (inclusive && compare == 0) {
+		left, right := split(node.right, key, inclusive, cmp)
+		node.right = left
+		node.update()
+		return node, right
+	}
+	left, right := split(node.left, key, inclusive, cmp)
+	node.left = right
+	node.update()
+	return left, node
+}
+
+// merge joins two subtrees, every key of the left one being less than every key of the right one.
+func merge[K comparable, V any](left, right *treapNode[K, V]) *treapNode[K, V] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	if left.priority > right.priority {
+		left.right = merge(left.right, right)
+		left.update()
+		return left
+	}
+	right.left = merge(left, right.left)
+	right.update()
+	return right
+}
+
+// union joins two subtrees with arbitrary keys. On repeated keys, the value of second is kept
+// if preferSecond is true, and the value of first otherwise.
+func union[K comparable, V any](first, second *treapNode[K, V], preferSecond bool, cmp cmpFunc[K]) *treapNode[K, V] {
+	if first == nil {
+		return second
+	}
+	if second == nil {
+		return first
+	}
+	if first.priority < second.priority {
+		first, second = second, first
+		preferSecond = !preferSecond
+	}
+
+	less, rest := split(second, first.key, false, cmp)
+	equal, greater := split(rest, first.key, true, cmp)
+	if equal != nil && preferSecond {
+		first.value = equal.value
+	}
+	first.left = union(first.left, less, preferSecond, cmp)
+	first.right = union(first.right, greater, preferSecond, cmp)
+	first.update()
+	return first
+}
+
+func (treap *treapMap[K, V]) removeRec(node *treapNode[K, V], key K) *treapNode[K, V] {
+	compare := treap.cmp(key, node.key)
+	if compare == 0 {
+		return merge(node.left, node.right)
+	}
+	if compare < 0 {
+		node.left = treap.removeRec(node.left, key)
+	} else {
+		node.right = treap.removeRec(node.right, key)
+	}
+	node.update()
+	return node
+}
+
+// withRoot creates a treap with the given root and the comparator of this one. Its random source is seeded
+// from the one of this treap, so that the shapes stay reproducible but the treaps share no state and can be
+// used from different goroutines.
+func (treap *treapMap[K, V]) withRoot(root *treapNode[K, V]) *treapMap[K, V] {
+	newTreap := new(treapMap[K, V])
+	newTreap.root = root
+	newTreap.cmp = treap.cmp
+	newTreap.rng = rand.New(rand.NewSource(treap.rng.Int63()))
+	return newTreap
+}
+
+// asTreap returns the treap behind other, or builds one with its elements if it is another implementation.
+func (treap *treapMap[K, V]) asTreap(other TreapMap[K, V]) *treapMap[K, V] {
+	if otherTreap, ok := other.(*treapMap[K, V]); ok {
+		return otherTreap
+	}
+	newTreap := treap.withRoot(nil)
+	other.Iterate(func(key K, value V) bool {
+		newTreap.Save(key, value)
+		return true
+	})
+	return newTreap
+}
+
+// ===================== CreateTreap ==========================
+
+// CreateTreap creates an ordered map backed by a treap whose priorities are drawn from a random source
+// seeded with the current time.
+func CreateTreap[K comparable, V any](cmpFunc func(K, K) int) TreapMap[K, V] {
+	return CreateTreapWithSeed[K, V](cmpFunc, time.Now().UnixNano())
+}
+
+// CreateTreapWithSeed creates an ordered map backed by a treap whose priorities are drawn from a random
+// source initialized with the given seed, so that its shape is reproducible.
+func CreateTreapWithSeed[K comparable, V any](cmpFunc func(K, K) int, seed int64) TreapMap[K, V] {
+	treap := new(treapMap[K, V])
+	treap.cmp = cmpFunc
+	treap.rng = rand.New(rand.NewSource(seed))
+	return treap
+}
+
+// ===================== Save() =======================
+
+func (treap *treapMap[K, V]) Save(key K, value V) {
+	if node := treap.findNode(key); node != nil {
+		node.value = value
+		return
+	}
+	left, right := split(treap.root, key, false, treap.cmp)
+	treap.root = merge(merge(left, treap.createNode(key, value)), right)
+	treap.modifications.Modified()
+}
+
+// ===================== Contains() ==========================
+
+func (treap *treapMap[K, V]) Contains(key K) bool {
+	return treap.findNode(key) != nil
+}
+
+// ===================== Get() ==========================
+
+func (treap *treapMap[K, V]) Get(key K) V {
+	node := treap.findNode(key)
+	if node == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	return node.value
+}
+
+func (treap *treapMap[K, V]) TryGet(key K) (V, error) {
+	node := treap.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return node.value, nil
+}
+
+// ===================== Remove() ==========================
+
+func (treap *treapMap[K, V]) Remove(key K) V {
+	node := treap.findNode(key)
+	if node == nil {
+		panic(_KEY_NOT_FOUND)
+	}
+	treap.root = treap.removeRec(treap.root, key)
+	treap.modifications.Modified()
+	return node.value
+}
+
+// ===================== Count() ==========================
+
+func (treap *treapMap[K, V]) Count() int {
+	return treap.root.count()
+}
+
+func (treap *treapMap[K, V]) comparator() cmpFunc[K] {
+	return treap.cmp
+}
+
+// ===================== Split(), Join() and Union() ==========================
+
+func (treap *treapMap[K, V]) Split(key K) (TreapMap[K, V], TreapMap[K, V]) {
+	left, right := split(treap.root, key, false, treap.cmp)
+	treap.root = nil
+	treap.modifications.Modified()
+	return treap.withRoot(left), treap.withRoot(right)
+}
+
+func (treap *treapMap[K, V]) Join(other TreapMap[K, V]) {
+	otherTreap := treap.asTreap(other)
+	if otherTreap == treap {
+		panic(_JOIN_ITSELF)
+	}
+	if treap.root != nil && otherTreap.root != nil {
+		last, first := treap.root, otherTreap.root
+		for last.right != nil {
+			last = last.right
+		}
+		for first.left != nil {
+			first = first.left
+		}
+		if treap.cmp(last.key, first.key) >= 0 {
+			panic(_JOIN_ORDER)
+		}
+	}
+	treap.root = merge(treap.root, otherTreap.root)
+	otherTreap.root = nil
+	treap.modifications.Modified()
+	otherTreap.modifications.Modified()
+}
+
+func (treap *treapMap[K, V]) Union(other TreapMap[K, V]) {
+	otherTreap := treap.asTreap(other)
+	if otherTreap == treap {
+		// The map already has every element of other, and emptying other would empty it
+		return
+	}
+	treap.root = union(treap.root, otherTreap.root, true, treap.cmp)
+	otherTreap.root = nil
+	treap.modifications.Modified()
+	otherTreap.modifications.Modified()
+}
+
+// =================== Internal Iterator ===================
+
+func (treap *treapMap[K, V]) Iterate(visit func(key K, value V) bool) {
+	treap.root.iterateRecursive(nil, nil, visit, treap.cmp)
+}
+
+func (treap *treapMap[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	treap.root.iterateRecursive(from, to, visit, treap.cmp)
+}
+
+func (node *treapNode[K, V]) iterateRecursive(from *K, to *K, visit func(key K, value V) bool, cmp func(K, K) int) bool {
+	if node == nil {
+		return true
+	}
+	if (from == nil || cmp(*from, node.key) < 0) && !node.left.iterateRecursive(from, to, visit, cmp) {
+		return false
+	}
+	if (from == nil || cmp(*from, node.key) <= 0) && (to == nil || cmp(node.key, *to) <= 0) && !visit(node.key, node.value) {
+		return false
+	}
+	if (to == nil || cmp(node.key, *to) < 0) && !node.right.iterateRecursive(from, to, visit, cmp) {
+		return false
+	}
+	return true
+}
+
+// =================== External Iterator ===================
+
+func (treap *treapMap[K, V]) Iterator() MapIterator[K, V] {
+	return treap.IteratorRange(nil, nil)
+}
+
+func (treap *treapMap[K, V]) IteratorRange(from *K, to *K) MapIterator[K, V] {
+	iterator := new(treapIter[K, V])
+	iterator.stack = ADTStack.NewStack[*treapNode[K, V]]()
+	iterator.treap = treap
+	iterator.modifications = modifications.NewCheck(&treap.modifications, _CONCURRENT_MODIFICATION)
+	iterator.from = from
+	iterator.to = to
+	iterator.pushLeftUntil(treap.root, from)
+	return iterator
+}
+
+func (iterator *treapIter[K, V]) pushLeftUntil(node *treapNode[K, V], from *K) {
+	for node != nil {
+		if from == nil || iterator.treap.cmp(node.key, *from) >= 0 {
+			iterator.stack.Push(node)
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+}
+
+func (iterator *treapIter[K, V]) HasNext() bool {
+	iterator.modifications.Verify()
+	return !iterator.stack.IsEmpty() &&
+		(iterator.to == nil || iterator.treap.cmp(iterator.stack.Peek().key, *iterator.to) <= 0)
+}
+
+func (iterator *treapIter[K, V]) Current() (K, V) {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	current := iterator.stack.Peek()
+	return current.key, current.value
+}
+
+func (iterator *treapIter[K, V]) Next() {
+	if !iterator.HasNext() {
+		panic(_ITERATOR_FINISH)
+	}
+	current := iterator.stack.Pop()
+	iterator.pushLeftUntil(current.right, iterator.from)
+}
+
+func (iterator *treapIter[K, V]) Seek(key K) {
+	iterator.modifications.Verify()
+	iterator.stack = ADTStack.NewStack[*treapNode[K, V]]()
+	iterator.pushLeftUntil(iterator.treap.root, seekStart(key, iterator.from, iterator.treap.cmp))
+}
+
+// Delete stacks the next elements, the lowest keys of the right subtree of the current node, before removing
+// it. Removing merges both subtrees of the node, which only changes the left links of the stacked nodes, and
+// the iterator only follows their right links from then on.
+func (iterator *treapIter[K, V]) Delete() V {
+	key, _ := iterator.Current()
+	current := iterator.stack.Pop()
+	iterator.pushLeftUntil(current.right, iterator.from)
+	removed := iterator.treap.Remove(key)
+	iterator.modifications.Sync()
+	return removed
+}
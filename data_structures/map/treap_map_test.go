@@ -0,0 +1,170 @@
+package mymap_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_TREAP_SEED  int64 = 7
+	_TREAP_SAVES       = 10000
+)
+
+func treapKeys(treap ADTMap.TreapMap[int, int]) []int {
+	keys := []int{}
+	treap.Iterate(func(key int, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func TestTreapEmpty(t *testing.T) {
+	treap := ADTMap.CreateTreapWithSeed[int, string](cmpInt, _TREAP_SEED)
+	require.EqualValues(t, 0, treap.Count())
+	require.False(t, treap.Contains(0))
+	require.Panics(t, func() { treap.Get(0) })
+	require.Panics(t, func() { treap.Remove(0) })
+
+	iter := treap.Iterator()
+	require.False(t, iter.HasNext())
+	require.Panics(t, func() { iter.Current() })
+	require.Panics(t, func() { iter.Next() })
+}
+
+func TestTreapMatchesBST(t *testing.T) {
+	rng := rand.New(rand.NewSource(_TREAP_SEED))
+	treap := ADTMap.CreateTreapWithSeed[int, int](cmpInt, _TREAP_SEED)
+	bst := ADTMap.CreateBST[int, int](cmpInt)
+
+	for i := 0; i < 20000; i++ {
+		key := rng.Intn(1000)
+		if rng.Intn(2) == 0 && bst.Contains(key) {
+			require.Equal(t, bst.Remove(key), treap.Remove(key))
+		} else {
+			treap.Save(key, i)
+			bst.Save(key, i)
+		}
+		require.Equal(t, bst.Count(), treap.Count())
+	}
+
+	from, to := 100, 500
+	iterTreap, iterBST := treap.IteratorRange(&from, &to), bst.IteratorRange(&from, &to)
+	for iterBST.HasNext() {
+		require.True(t, iterTreap.HasNext())
+		keyBST, valueBST := iterBST.Current()
+		keyTreap, valueTreap := iterTreap.Current()
+		require.Equal(t, keyBST, keyTreap)
+		require.Equal(t, valueBST, valueTreap)
+		iterBST.Next()
+		iterTreap.Next()
+	}
+	require.False(t, iterTreap.HasNext())
+}
+
+func TestTreapSplitAndJoin(t *testing.T) {
+	treap := ADTMap.CreateTreapWithSeed[int, int](cmpInt, _TREAP_SEED)
+	for _, key := range rand.Perm(100) {
+		treap.Save(key, key)
+	}
+
+	left, right := treap.Split(40)
+	require.EqualValues(t, 0, treap.Count(), "The split map should be left empty")
+	require.EqualValues(t, 40, left.Count())
+	require.EqualValues(t, 60, right.Count())
+	require.False(t, left.Contains(40))
+	require.True(t, right.Contains(40))
+	for i, key := range treapKeys(left) {
+		require.Equal(t, i, key)
+	}
+	for i, key := range treapKeys(right) {
+		require.Equal(t, i+40, key)
+	}
+
+	require.Panics(t, func() { right.Join(left) }, "Cannot join a map with lower keys")
+
+	left.Join(right)
+	require.EqualValues(t, 100, left.Count())
+	require.EqualValues(t, 0, right.Count())
+	for i, key := range treapKeys(left) {
+		require.Equal(t, i, key)
+	}
+
+	// Splitting at a key outside the map leaves one side empty
+	low, high := left.Split(-1)
+	require.EqualValues(t, 0, low.Count())
+	require.EqualValues(t, 100, high.Count())
+}
+
+func TestTreapSplitHalvesAreIndependent(t *testing.T) {
+	treap := ADTMap.CreateTreapWithSeed[int, int](cmpInt, _TREAP_SEED)
+	for key := 0; key < 100; key++ {
+		treap.Save(key, key)
+	}
+	left, right := treap.Split(50)
+
+	// Each half draws the priorities of its new nodes from its own source, so they can grow at the same time
+	start := make(chan struct{})
+	var wait sync.WaitGroup
+	for i, half := range []ADTMap.TreapMap[int, int]{left, right} {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			<-start
+			for key := 0; key < _TREAP_SAVES; key++ {
+				half.Save(_TREAP_SAVES*(i+1)+key, key)
+			}
+		}()
+	}
+	close(start)
+	wait.Wait()
+	require.EqualValues(t, 50+_TREAP_SAVES, left.Count())
+	require.EqualValues(t, 50+_TREAP_SAVES, right.Count())
+}
+
+func TestTreapUnion(t *testing.T) {
+	first := ADTMap.CreateTreapWithSeed[int, int](cmpInt, _TREAP_SEED)
+	second := ADTMap.CreateTreapWithSeed[int, int](cmpInt, _TREAP_SEED+1)
+	expected := make(map[int]int)
+
+	for i := 0; i < 300; i += 2 {
+		first.Save(i, i)
+		expected[i] = i
+	}
+	for i := 0; i < 300; i += 3 {
+		second.Save(i, -i)
+		expected[i] = -i
+	}
+
+	first.Union(second)
+	require.EqualValues(t, len(expected), first.Count())
+	require.EqualValues(t, 0, second.Count())
+	previous := -1
+	first.Iterate(func(key int, value int) bool {
+		require.Greater(t, key, previous)
+		require.Equal(t, expected[key], value, "On repeated keys the value of the other map should be kept")
+		previous = key
+		return true
+	})
+}
+
+func TestTreapJoinAndUnionWithItself(t *testing.T) {
+	treap := ADTMap.CreateTreapWithSeed[int, int](cmpInt, _TREAP_SEED)
+	require.PanicsWithValue(t, "A map cannot be joined with itself", func() { treap.Join(treap) })
+	for key := 0; key < 100; key++ {
+		treap.Save(key, key)
+	}
+	require.PanicsWithValue(t, "A map cannot be joined with itself", func() { treap.Join(treap) })
+	require.EqualValues(t, 100, treap.Count())
+
+	treap.Union(treap)
+	require.EqualValues(t, 100, treap.Count(), "Uniting the map with itself should leave it unchanged")
+	for i, key := range treapKeys(treap) {
+		require.Equal(t, i, key)
+	}
+}
@@ -0,0 +1,74 @@
+package mymap
+
+import (
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+)
+
+// ===================== closedHash ==========================
+
+// Validate checks the counters of the table and that every key can be found by probing from its hash, which
+// fails if an empty cell breaks its probe chain or if the key is stored twice.
+func (hash *closedHash[K, V]) Validate() error {
+	if hash.size != len(hash.table) {
+		return debug.Violation("the size %d differs from the length of the table %d", hash.size, len(hash.table))
+	}
+	occupied, deleted := 0, 0
+	for i, cell := range hash.table {
+		switch cell.state {
+		case OCCUPIED:
+			occupied++
+			if position := hash.getPosition(cell.key, false); position != i {
+				return debug.Violation("the key %v at %d is found by probing at %d", cell.key, i, position)
+			}
+		case DELETED:
+			deleted++
+		}
+	}
+	if occupied != hash.count || deleted != hash.deleted {
+		return debug.Violation("there are %d keys and %d deleted cells, but the counters are %d and %d",
+			occupied, deleted, hash.count, hash.deleted)
+	}
+	if loadFactor := hash.loadFactor(); loadFactor >= hash.options.GrowLoadFactor {
+		return debug.Violation("the load factor %.2f should have made the table grow", loadFactor)
+	}
+	return nil
+}
+
+// ===================== bst ==========================
+
+// Validate checks that the keys of every left subtree are lower than the key of their root, those of every
+// right subtree are greater, and that the tree has as many nodes as its size.
+func (bst *bst[K, V]) Validate() error {
+	type bounded struct {
+		node         *bstNode[K, V]
+		lower, upper *K
+	}
+	nodes := 0
+	stack := ADTStack.NewStack[bounded]()
+	if bst.root != nil {
+		stack.Push(bounded{node: bst.root})
+	}
+	for !stack.IsEmpty() {
+		current := stack.Pop()
+		node := current.node
+		nodes++
+		if current.lower != nil && bst.cmp(node.key, *current.lower) <= 0 {
+			return debug.Violation("the key %v is not greater than its ancestor %v", node.key, *current.lower)
+		}
+		if current.upper != nil && bst.cmp(node.key, *current.upper) >= 0 {
+			return debug.Violation("the key %v is not lower than its ancestor %v", node.key, *current.upper)
+		}
+		if node.left != nil {
+			stack.Push(bounded{node.left, current.lower, &node.key})
+		}
+		if node.right != nil {
+			stack.Push(bounded{node.right, &node.key, current.upper})
+		}
+	}
+	if nodes != bst.size {
+		return debug.Violation("the tree has %d nodes, but its size is %d", nodes, bst.size)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package mymap_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapsValidate(t *testing.T) {
+	factories := map[string]func() ADTMap.Map[int, int]{
+		"Hash":     ADTMap.NewHash[int, int],
+		"BST":      func() ADTMap.Map[int, int] { return ADTMap.CreateBST[int, int](cmpInt) },
+		"ArenaBST": func() ADTMap.Map[int, int] { return ADTMap.CreateArenaBST[int, int](cmpInt) },
+	}
+	for name, create := range factories {
+		t.Run(name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(1))
+			dic := create()
+			require.Implements(t, (*debug.Validator)(nil), dic)
+			for i := 0; i < 2000; i++ {
+				// Mostly saving and then mostly removing, so that the table grows and shrinks
+				key := rng.Intn(500)
+				if (i < 1000) == (rng.Intn(4) > 0) {
+					dic.Save(key, i)
+				} else if dic.Contains(key) {
+					dic.Remove(key)
+				}
+				require.NoError(t, debug.Validate(dic))
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package priority_queue
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+)
+
+// Heaps implement codec.Serializable. The heap is encoded as its array, which is already a valid heap. The
+// comparator cannot be encoded, so the data must be unmarshalled into a heap created with the same
+// comparator, which rebuilds the heap property in O(n) in case the elements were encoded by a heap with a
+// different one. The binary form uses the default codec of the elements, and MarshalWith and UnmarshalWith
+// encode any priority queue with a given one.
+
+// MarshalWith encodes the elements of the queue in binary, in the order Iterate visits them, with the given codec.
+func MarshalWith[T any](queue PriorityQueue[T], elements codec.Codec[T]) ([]byte, error) {
+	return codec.Slice(elements).Append(nil, codec.Collect(queue.Iterate))
+}
+
+// UnmarshalWith replaces the contents of the queue with the elements encoded by MarshalWith with the same codec,
+// ordered by the comparator of the queue. If the data is not valid, the queue is left untouched.
+func UnmarshalWith[T any](queue PriorityQueue[T], data []byte, elements codec.Codec[T]) error {
+	decoded := []T{}
+	if err := codec.UnmarshalSequence(data, elements, func(element T) { decoded = append(decoded, element) }); err != nil {
+		return err
+	}
+	replaceContents(queue, decoded)
+	return nil
+}
+
+// replaceContents empties the queue and adds the elements, all at once if the queue is synchronized. The
+// heaps of this package are rebuilt in O(n).
+func replaceContents[T any](queue PriorityQueue[T], elements []T) {
+	switch queue := queue.(type) {
+	case *priorityQueue[T]:
+		queue.replace(elements)
+	case SynchronizedQueue[T]:
+		queue.Update(func(queue PriorityQueue[T]) { replaceContents(queue, elements) })
+	default:
+		for !queue.IsEmpty() {
+			queue.Dequeue()
+		}
+		for _, element := range elements {
+			queue.Enqueue(element)
+		}
+	}
+}
+
+func (heap *priorityQueue[T]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[T](heap, codec.Default[T]())
+}
+
+func (heap *priorityQueue[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSequenceJSON(heap.size, heap.Iterate)
+}
+
+func (heap *priorityQueue[T]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[T](heap, data, codec.Default[T]())
+}
+
+func (heap *priorityQueue[T]) UnmarshalJSON(data []byte) error {
+	elements := []T{}
+	add := func(element T) { elements = append(elements, element) }
+	if err := codec.UnmarshalSequenceJSON(data, add); err != nil {
+		return err
+	}
+	heap.replace(elements)
+	return nil
+}
+
+func (heap *priorityQueue[T]) replace(elements []T) {
+	heapify(elements, heap.cmp)
+	heap.data = make([]T, max(_INITIAL_SIZE, len(elements)))
+	copy(heap.data, elements)
+	heap.size = len(elements)
+}
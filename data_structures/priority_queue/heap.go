@@ -1,5 +1,11 @@
 package priority_queue
 
+import (
+	"cmp"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/compare"
+)
+
 const (
 	_INITIAL_SIZE        = 15
 	_RESIZE_FACTOR       = 2
@@ -71,10 +77,20 @@ func NewHeap[T any](cmpFunc func(T, T) int) PriorityQueue[T] {
 	return NewHeapFromArray([]T{}, cmpFunc)
 }
 
+// NewMinHeap creates a priority queue where the lowest element has the highest priority.
+func NewMinHeap[T cmp.Ordered]() PriorityQueue[T] {
+	return NewHeap(compare.Reverse(compare.Natural[T]()))
+}
+
+// NewMaxHeap creates a priority queue where the greatest element has the highest priority.
+func NewMaxHeap[T cmp.Ordered]() PriorityQueue[T] {
+	return NewHeap(compare.Natural[T]())
+}
+
 func NewHeapFromArray[T any](array []T, cmpFunc func(T, T) int) PriorityQueue[T] {
 	newArr := make([]T, max(_INITIAL_SIZE, len(array)))
 	copy(newArr, array)
-	heapify(newArr, cmpFunc)
+	heapify(newArr[:len(array)], cmpFunc)
 
 	heap := new(priorityQueue[T])
 	heap.data = newArr
@@ -122,6 +138,14 @@ func (heap *priorityQueue[T]) Size() int {
 	return heap.size
 }
 
+func (heap *priorityQueue[T]) Iterate(visit func(T) bool) {
+	for _, element := range heap.data[:heap.size] {
+		if !visit(element) {
+			return
+		}
+	}
+}
+
 // ======================= HeapSort ========================
 
 func HeapSort[T any](elements []T, cmpFunc func(T, T) int) {
@@ -0,0 +1,173 @@
+// Package heaptest verifies that an implementation of priority_queue.PriorityQueue behaves as the package
+// documents, so that a new implementation can be checked with a single call to RunConformance from its tests.
+package heaptest
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+	TDAHeap "github.com/sebagarciad/algorithms-and-data-structures/priority_queue"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_EMPTY_QUEUE = "The queue is empty"
+	_VOLUME      = 10000
+	_RANDOM_OPS  = 5000
+)
+
+func cmpInt(a, b int) int {
+	return a - b
+}
+
+func cmpReverse(a, b int) int {
+	return b - a
+}
+
+// RunConformance runs the behavioural, volume, encoding and randomized tests against the priority queues
+// created by factory, each of which must be empty and order its elements with the given comparator.
+// The encoding tests are skipped for the queues that do not implement codec.Serializable.
+func RunConformance(t *testing.T, factory func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int]) {
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, factory) })
+	t.Run("Priority", func(t *testing.T) { testPriority(t, factory) })
+	t.Run("Comparator", func(t *testing.T) { testComparator(t, factory) })
+	t.Run("Iterate", func(t *testing.T) { testIterate(t, factory) })
+	t.Run("Volume", func(t *testing.T) { testVolume(t, factory) })
+	t.Run("Encoding", func(t *testing.T) { testEncoding(t, factory) })
+	t.Run("Randomized", func(t *testing.T) { testRandomized(t, factory) })
+}
+
+func testEmpty(t *testing.T, factory func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int]) {
+	heap := factory(cmpInt)
+	require.True(t, heap.IsEmpty())
+	require.EqualValues(t, 0, heap.Size())
+	require.PanicsWithValue(t, _EMPTY_QUEUE, func() { heap.PeekMax() })
+	require.PanicsWithValue(t, _EMPTY_QUEUE, func() { heap.Dequeue() })
+	_, err := TDAHeap.TryPeekMax(heap)
+	require.ErrorIs(t, err, TDAHeap.ErrEmpty)
+	_, err = TDAHeap.TryDequeue(heap)
+	require.ErrorIs(t, err, TDAHeap.ErrEmpty)
+}
+
+func testPriority(t *testing.T, factory func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int]) {
+	heap := factory(cmpInt)
+	elements := []int{5, 1, 9, 3, 9, 7, 1}
+	maximum := elements[0]
+	for i, element := range elements {
+		heap.Enqueue(element)
+		maximum = max(maximum, element)
+		require.Equal(t, maximum, heap.PeekMax())
+		require.EqualValues(t, i+1, heap.Size())
+	}
+
+	slices.SortFunc(elements, cmpReverse)
+	for _, expected := range elements {
+		require.Equal(t, expected, heap.Dequeue(), "Repeated elements should all be dequeued")
+	}
+	require.True(t, heap.IsEmpty())
+}
+
+func testComparator(t *testing.T, factory func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int]) {
+	heap := factory(cmpReverse)
+	for _, element := range []int{4, 2, 8, 6} {
+		heap.Enqueue(element)
+	}
+	for _, expected := range []int{2, 4, 6, 8} {
+		require.Equal(t, expected, heap.Dequeue(), "The comparator decides which element has the highest priority")
+	}
+}
+
+func testIterate(t *testing.T, factory func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int]) {
+	heap := factory(cmpInt)
+	for _, element := range []int{3, 1, 4, 1, 5} {
+		heap.Enqueue(element)
+	}
+	visited := []int{}
+	heap.Iterate(func(element int) bool {
+		visited = append(visited, element)
+		return true
+	})
+	require.ElementsMatch(t, []int{3, 1, 4, 1, 5}, visited)
+	require.Equal(t, 5, heap.Size(), "Iterating should not modify the queue")
+
+	visited = visited[:0]
+	heap.Iterate(func(element int) bool {
+		visited = append(visited, element)
+		return false
+	})
+	require.Len(t, visited, 1, "The iteration should stop when visit returns false")
+}
+
+func testVolume(t *testing.T, factory func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int]) {
+	heap := factory(cmpInt)
+	for _, element := range rand.New(rand.NewSource(1)).Perm(_VOLUME) {
+		heap.Enqueue(element)
+	}
+	require.EqualValues(t, _VOLUME, heap.Size())
+	for i := _VOLUME - 1; i >= 0; i-- {
+		require.Equal(t, i, heap.Dequeue())
+	}
+	require.True(t, heap.IsEmpty())
+}
+
+func testEncoding(t *testing.T, factory func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int]) {
+	heap := factory(cmpInt)
+	if _, ok := heap.(codec.Serializable); !ok {
+		t.Skip("The queue does not implement codec.Serializable")
+	}
+	for _, element := range rand.New(rand.NewSource(1)).Perm(100) {
+		heap.Enqueue(element)
+	}
+
+	binary, err := heap.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+	fromBinary := factory(cmpInt)
+	fromBinary.Enqueue(1000)
+	require.NoError(t, fromBinary.(codec.Serializable).UnmarshalBinary(binary))
+
+	text, err := heap.(codec.Serializable).MarshalJSON()
+	require.NoError(t, err)
+	fromJSON := factory(cmpInt)
+	require.NoError(t, fromJSON.(codec.Serializable).UnmarshalJSON(text))
+
+	require.EqualValues(t, 100, heap.Size(), "Marshalling should not modify the queue")
+	require.EqualValues(t, 100, fromBinary.Size(), "Unmarshalling should replace the contents of the queue")
+	for i := 99; i >= 0; i-- {
+		require.Equal(t, i, fromBinary.Dequeue())
+		require.Equal(t, i, fromJSON.Dequeue())
+	}
+}
+
+// testRandomized applies random operations to the queue and to a sorted slice, and checks that both always
+// agree on the maximum and, if the queue is a debug.Validator, that its invariants hold.
+func testRandomized(t *testing.T, factory func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int]) {
+	rng := rand.New(rand.NewSource(1))
+	heap := factory(cmpInt)
+	model := []int{}
+	for i := 0; i < _RANDOM_OPS; i++ {
+		if rng.Intn(3) < 2 {
+			element := rng.Intn(1000)
+			heap.Enqueue(element)
+			index, _ := slices.BinarySearch(model, element)
+			model = slices.Insert(model, index, element)
+		} else {
+			top, err := TDAHeap.TryDequeue(heap)
+			if len(model) == 0 {
+				require.ErrorIs(t, err, TDAHeap.ErrEmpty)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, model[len(model)-1], top)
+			model = model[:len(model)-1]
+		}
+		require.NoError(t, debug.Validate(heap))
+		require.EqualValues(t, len(model), heap.Size())
+		if len(model) > 0 {
+			require.Equal(t, model[len(model)-1], heap.PeekMax())
+		}
+	}
+}
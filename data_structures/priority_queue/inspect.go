@@ -0,0 +1,33 @@
+package priority_queue
+
+import (
+	"fmt"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
+)
+
+// The heap implements debug.Inspector by copying the implicit tree of its array, where the children of the
+// element at i are at 2i+1 and 2i+2, into a debug.TreeNode tree.
+
+func (heap *priorityQueue[T]) DOT() string {
+	return debug.DOT(heap.inspect(0))
+}
+
+func (heap *priorityQueue[T]) ASCII() string {
+	return debug.ASCII(heap.inspect(0))
+}
+
+func (heap *priorityQueue[T]) TreeStats() debug.TreeStats {
+	return debug.Stats(heap.inspect(0))
+}
+
+func (heap *priorityQueue[T]) inspect(index int) *debug.TreeNode {
+	if index >= heap.size {
+		return nil
+	}
+	return &debug.TreeNode{
+		Label: fmt.Sprintf("%v", heap.data[index]),
+		Left:  heap.inspect(leftChild(index)),
+		Right: heap.inspect(rightChild(index)),
+	}
+}
@@ -1,5 +1,10 @@
 package priority_queue
 
+import "errors"
+
+// ErrEmpty is returned by TryPeekMax and TryDequeue when the priority queue is empty.
+var ErrEmpty = errors.New("priority_queue: empty queue")
+
 type PriorityQueue[T any] interface {
 
 	// IsEmpty returns true if the queue is empty, false otherwise.
@@ -18,4 +23,33 @@ type PriorityQueue[T any] interface {
 
 	// Size returns the number of elements in the priority queue.
 	Size() int
+
+	// Iterate traverses the elements of the queue in no particular order, executing the "visit" function on each
+	// of them. If "visit" returns false, the iteration stops.
+	Iterate(visit func(T) bool)
+}
+
+// TryPeekMax returns the element with the highest priority, or ErrEmpty if the queue is empty. Like
+// TryDequeue, it calls the method of the same name if the queue has one, as the synchronized queues do.
+func TryPeekMax[T any](queue PriorityQueue[T]) (T, error) {
+	if queue, ok := queue.(interface{ TryPeekMax() (T, error) }); ok {
+		return queue.TryPeekMax()
+	}
+	if queue.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return queue.PeekMax(), nil
+}
+
+// TryDequeue removes and returns the element with the highest priority, or ErrEmpty if the queue is empty.
+func TryDequeue[T any](queue PriorityQueue[T]) (T, error) {
+	if queue, ok := queue.(interface{ TryDequeue() (T, error) }); ok {
+		return queue.TryDequeue()
+	}
+	if queue.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return queue.Dequeue(), nil
 }
@@ -2,10 +2,15 @@ package priority_queue_test
 
 import (
 	"math/rand"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	"github.com/sebagarciad/algorithms-and-data-structures/debug"
 	TDAHeap "github.com/sebagarciad/algorithms-and-data-structures/priority_queue"
+	"github.com/sebagarciad/algorithms-and-data-structures/priority_queue/heaptest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -311,3 +316,165 @@ func TestHeapSortVolume(t *testing.T) {
 	TDAHeap.HeapSort(elements, cmpInt)
 	require.Equal(t, expected, elements, "HeapSort works correctly with a large amount of unordered elements")
 }
+
+func TestHeapEncoding(t *testing.T) {
+	cmpInt := func(a, b int) int { return a - b }
+	heap := TDAHeap.NewHeap(cmpInt)
+	for _, element := range rand.Perm(100) {
+		heap.Enqueue(element)
+	}
+
+	binary, err := heap.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+	decoded := TDAHeap.NewHeap(cmpInt)
+	require.NoError(t, decoded.(codec.Serializable).UnmarshalBinary(binary))
+	require.Equal(t, 100, decoded.Size())
+
+	text, err := heap.(codec.Serializable).MarshalJSON()
+	require.NoError(t, err)
+	minHeap := TDAHeap.NewHeap(func(a, b int) int { return b - a })
+	require.NoError(t, minHeap.(codec.Serializable).UnmarshalJSON(text))
+
+	for i := 0; i < 100; i++ {
+		require.Equal(t, 99-i, decoded.Dequeue())
+		require.Equal(t, i, minHeap.Dequeue(), "The heap should be rebuilt with its own comparator")
+	}
+	require.Equal(t, 99, heap.PeekMax(), "Marshalling should not modify the heap")
+}
+
+func TestHeapTryMethods(t *testing.T) {
+	heap := TDAHeap.NewHeap(cmpInt)
+	_, err := TDAHeap.TryPeekMax(heap)
+	require.ErrorIs(t, err, TDAHeap.ErrEmpty)
+	_, err = TDAHeap.TryDequeue(heap)
+	require.ErrorIs(t, err, TDAHeap.ErrEmpty)
+
+	heap.Enqueue(3)
+	heap.Enqueue(7)
+	top, err := TDAHeap.TryPeekMax(heap)
+	require.NoError(t, err)
+	require.Equal(t, 7, top)
+	top, err = TDAHeap.TryDequeue(heap)
+	require.NoError(t, err)
+	require.Equal(t, 7, top)
+	require.Equal(t, 1, heap.Size())
+}
+
+func TestHeapConformance(t *testing.T) {
+	t.Run("NewHeap", func(t *testing.T) {
+		heaptest.RunConformance(t, TDAHeap.NewHeap[int])
+	})
+	t.Run("NewHeapFromArray", func(t *testing.T) {
+		heaptest.RunConformance(t, func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int] {
+			return TDAHeap.NewHeapFromArray([]int{}, cmp)
+		})
+	})
+}
+
+func TestHeapFromArrayValidates(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for range 100 {
+		array := make([]int, rng.Intn(40))
+		for i := range array {
+			array[i] = rng.Intn(200) - 100
+		}
+		heap := TDAHeap.NewHeapFromArray(array, cmpInt)
+		require.Implements(t, (*debug.Validator)(nil), heap)
+		require.NoError(t, debug.Validate(heap), "The unused positions of the array should not be heapified")
+
+		slices.SortFunc(array, func(a, b int) int { return b - a })
+		for _, expected := range array {
+			require.Equal(t, expected, heap.Dequeue())
+			require.NoError(t, debug.Validate(heap))
+		}
+	}
+}
+
+func TestHeapInspector(t *testing.T) {
+	heap := TDAHeap.NewHeapFromArray([]int{1, 2, 3, 4, 5}, cmpInt)
+	inspector, ok := heap.(debug.Inspector)
+	require.True(t, ok)
+
+	require.Equal(t, "5\n"+
+		"|-- 4\n"+
+		"|   |-- 1\n"+
+		"|   `-- 2\n"+
+		"`-- 3\n", inspector.ASCII())
+	require.Contains(t, inspector.DOT(), "n0 -> n1;")
+	require.Equal(t, debug.TreeStats{Nodes: 5, Height: 3, Leaves: 3, AverageDepth: 1.2, MaxImbalance: 1},
+		inspector.TreeStats())
+}
+
+func TestSynchronizedHeapConformance(t *testing.T) {
+	heaptest.RunConformance(t, func(cmp func(a, b int) int) TDAHeap.PriorityQueue[int] {
+		return TDAHeap.NewSynchronized(TDAHeap.NewHeap(cmp))
+	})
+}
+
+func TestSynchronizedHeapConcurrentUse(t *testing.T) {
+	const goroutines, enqueues = 8, 500
+	heap := TDAHeap.NewSynchronized(TDAHeap.NewHeap(cmpInt))
+	var group sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		group.Add(1)
+		go func(g int) {
+			defer group.Done()
+			for i := 0; i < enqueues; i++ {
+				heap.Enqueue(g*enqueues + i)
+				heap.DequeueIfNotEmpty()
+				heap.Enqueue(g*enqueues + i)
+			}
+		}(g)
+	}
+	group.Wait()
+
+	require.NoError(t, debug.Validate(heap))
+	require.Equal(t, goroutines*enqueues, heap.Size())
+	previous, ok := heap.DequeueIfNotEmpty()
+	for ok {
+		var top int
+		if top, ok = heap.DequeueIfNotEmpty(); ok {
+			require.LessOrEqual(t, top, previous)
+			previous = top
+		}
+	}
+}
+
+func TestMinAndMaxHeaps(t *testing.T) {
+	minHeap, maxHeap := TDAHeap.NewMinHeap[string](), TDAHeap.NewMaxHeap[string]()
+	for _, word := range []string{"pear", "apple", "quince", "fig"} {
+		minHeap.Enqueue(word)
+		maxHeap.Enqueue(word)
+	}
+	for _, expected := range []string{"apple", "fig", "pear", "quince"} {
+		require.Equal(t, expected, minHeap.Dequeue())
+	}
+	for _, expected := range []string{"quince", "pear", "fig", "apple"} {
+		require.Equal(t, expected, maxHeap.Dequeue())
+	}
+}
+
+func TestHeapEncodingWithCodec(t *testing.T) {
+	cmpInt := func(a, b int) int { return a - b }
+	elements := codec.JSON[int]()
+	for name, heap := range map[string]TDAHeap.PriorityQueue[int]{
+		"Heap":         TDAHeap.NewHeap(cmpInt),
+		"Synchronized": TDAHeap.NewSynchronized(TDAHeap.NewHeap(cmpInt)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, element := range rand.Perm(50) {
+				heap.Enqueue(element)
+			}
+			binary, err := TDAHeap.MarshalWith(heap, elements)
+			require.NoError(t, err)
+
+			heap.Enqueue(100)
+			require.NoError(t, TDAHeap.UnmarshalWith(heap, binary, elements))
+			require.ErrorIs(t, TDAHeap.UnmarshalWith(heap, binary[:len(binary)-1], elements), codec.ErrTruncated)
+			require.Equal(t, 50, heap.Size(), "Unmarshalling should replace the contents of the queue")
+			for i := 49; i >= 0; i-- {
+				require.Equal(t, i, heap.Dequeue())
+			}
+		})
+	}
+}
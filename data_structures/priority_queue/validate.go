@@ -0,0 +1,16 @@
+package priority_queue
+
+import "github.com/sebagarciad/algorithms-and-data-structures/debug"
+
+// Validate checks that the elements fit in the array and that no element has a higher priority than its parent.
+func (heap *priorityQueue[T]) Validate() error {
+	if heap.size < 0 || heap.size > len(heap.data) {
+		return debug.Violation("the size %d does not fit in an array of length %d", heap.size, len(heap.data))
+	}
+	for i := 1; i < heap.size; i++ {
+		if heap.cmp(heap.data[i], heap.data[parent(i)]) > _COMPARISON {
+			return debug.Violation("the element at %d has a higher priority than its parent", i)
+		}
+	}
+	return nil
+}
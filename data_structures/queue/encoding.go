@@ -0,0 +1,68 @@
+package queue
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+)
+
+// Queues implement codec.Serializable: they are encoded from the front to the end, and rebuilt by enqueuing
+// the elements back in that order. The binary form uses the default codec of the elements, and MarshalWith
+// and UnmarshalWith encode any queue with a given one.
+
+// MarshalWith encodes the elements of the queue in binary, from the front to the end, with the given codec.
+func MarshalWith[T any](queue Queue[T], elements codec.Codec[T]) ([]byte, error) {
+	return codec.Slice(elements).Append(nil, codec.Collect(queue.Iterate))
+}
+
+// UnmarshalWith replaces the contents of the queue with the elements encoded by MarshalWith with the same codec.
+// If the data is not valid, the queue is left untouched.
+func UnmarshalWith[T any](queue Queue[T], data []byte, elements codec.Codec[T]) error {
+	decoded := []T{}
+	if err := codec.UnmarshalSequence(data, elements, func(element T) { decoded = append(decoded, element) }); err != nil {
+		return err
+	}
+	replaceContents(queue, decoded)
+	return nil
+}
+
+// replaceContents empties the queue and enqueues the elements, all at once if the queue is synchronized.
+func replaceContents[T any](queue Queue[T], elements []T) {
+	if synchronized, isSynchronized := queue.(SynchronizedQueue[T]); isSynchronized {
+		synchronized.Update(func(queue Queue[T]) { replaceContents(queue, elements) })
+		return
+	}
+	for !queue.IsEmpty() {
+		queue.Dequeue()
+	}
+	for _, element := range elements {
+		queue.Enqueue(element)
+	}
+}
+
+func (q *linkedQueue[T]) length() int {
+	length := 0
+	for node := q.first; node != nil; node = node.next {
+		length++
+	}
+	return length
+}
+
+func (q *linkedQueue[T]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[T](q, codec.Default[T]())
+}
+
+func (q *linkedQueue[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSequenceJSON(q.length(), q.Iterate)
+}
+
+func (q *linkedQueue[T]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[T](q, data, codec.Default[T]())
+}
+
+func (q *linkedQueue[T]) UnmarshalJSON(data []byte) error {
+	decoded := []T{}
+	if err := codec.UnmarshalSequenceJSON(data, func(element T) { decoded = append(decoded, element) }); err != nil {
+		return err
+	}
+	replaceContents[T](q, decoded)
+	return nil
+}
@@ -1,23 +1,46 @@
 package queue
 
+import "github.com/sebagarciad/algorithms-and-data-structures/internal/arena"
+
 type queueNode[T any] struct {
 	data T
 	next *queueNode[T]
 }
 
-func newQueueNode[T any](data T) *queueNode[T] {
-	node := new(queueNode[T])
+type linkedQueue[T any] struct {
+	first *queueNode[T]
+	last  *queueNode[T]
+	// nodes is the arena the nodes are allocated from, or nil if each one is allocated on its own
+	nodes *arena.Arena[queueNode[T]]
+}
+
+// newQueueNode allocates a node for the element, from the arena of the queue if it has one.
+func (q *linkedQueue[T]) newQueueNode(data T) *queueNode[T] {
+	var node *queueNode[T]
+	if q.nodes != nil {
+		node = q.nodes.Alloc()
+	} else {
+		node = new(queueNode[T])
+	}
 	node.data = data
 	return node
 }
 
-type linkedQueue[T any] struct {
-	first *queueNode[T]
-	last  *queueNode[T]
+func newLinkedQueue[T any](withArena bool) *linkedQueue[T] {
+	q := new(linkedQueue[T])
+	if withArena {
+		q.nodes = arena.New(func(node *queueNode[T]) **queueNode[T] { return &node.next })
+	}
+	return q
 }
 
 func NewLinkedQueue[T any]() Queue[T] {
-	return new(linkedQueue[T])
+	return newLinkedQueue[T](false)
+}
+
+// NewArenaLinkedQueue creates a linked queue whose nodes are allocated in slabs and reused once dequeued.
+func NewArenaLinkedQueue[T any]() Queue[T] {
+	return newLinkedQueue[T](true)
 }
 
 func (q *linkedQueue[T]) IsEmpty() bool {
@@ -32,7 +55,7 @@ func (q *linkedQueue[T]) Peek() T {
 }
 
 func (q *linkedQueue[T]) Enqueue(data T) {
-	node := newQueueNode(data)
+	node := q.newQueueNode(data)
 	if q.first == nil {
 		q.first = node
 		q.last = node
@@ -46,10 +69,22 @@ func (q *linkedQueue[T]) Dequeue() T {
 	if q.IsEmpty() {
 		panic("The queue is empty")
 	}
-	element := q.first.data
-	q.first = q.first.next
+	first := q.first
+	q.first = first.next
 	if q.first == nil {
 		q.last = nil
 	}
+	element := first.data
+	if q.nodes != nil {
+		q.nodes.Free(first)
+	}
 	return element
 }
+
+func (q *linkedQueue[T]) Iterate(visit func(T) bool) {
+	for node := q.first; node != nil; node = node.next {
+		if !visit(node.data) {
+			return
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package queue
 
+import "errors"
+
+// ErrEmpty is returned by TryPeek and TryDequeue when the queue is empty.
+var ErrEmpty = errors.New("queue: empty queue")
+
 type Queue[T any] interface {
 
 	// IsEmpty returns true if the queue has no enqueued elements, false otherwise.
@@ -15,4 +20,33 @@ type Queue[T any] interface {
 	// Dequeue removes the first element from the queue. If the queue has elements, it removes the first one
 	// and returns its value. If it is empty, it panics with the message "The queue is empty".
 	Dequeue() T
+
+	// Iterate traverses the elements from the front to the end of the queue, executing the "visit" function on
+	// each of them. If "visit" returns false, the iteration stops.
+	Iterate(visit func(T) bool)
+}
+
+// TryPeek returns the value at the front of the queue, or ErrEmpty if it is empty. Like TryDequeue, it
+// calls the method of the same name if the queue has one, so that a synchronized queue answers atomically.
+func TryPeek[T any](queue Queue[T]) (T, error) {
+	if queue, ok := queue.(interface{ TryPeek() (T, error) }); ok {
+		return queue.TryPeek()
+	}
+	if queue.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return queue.Peek(), nil
+}
+
+// TryDequeue removes the first element from the queue and returns its value, or ErrEmpty if it is empty.
+func TryDequeue[T any](queue Queue[T]) (T, error) {
+	if queue, ok := queue.(interface{ TryDequeue() (T, error) }); ok {
+		return queue.TryDequeue()
+	}
+	if queue.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return queue.Dequeue(), nil
 }
@@ -1,9 +1,12 @@
 package queue_test
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
 	ADTQueue "github.com/sebagarciad/algorithms-and-data-structures/queue"
+	"github.com/sebagarciad/algorithms-and-data-structures/queue/queuetest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -202,3 +205,142 @@ func TestDequeue(t *testing.T) {
 	require.Equal(t, _FLOAT4, floatQueue.Dequeue(), "Should return the float 12457.532")
 	require.True(t, floatQueue.IsEmpty(), "After dequeuing all elements, IsEmpty should return True")
 }
+
+func TestQueueEncoding(t *testing.T) {
+	queue := ADTQueue.NewLinkedQueue[int]()
+	for i := 0; i < 100; i++ {
+		queue.Enqueue(i)
+	}
+
+	binary, err := queue.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+	decoded := ADTQueue.NewLinkedQueue[int]()
+	decoded.Enqueue(-1)
+	require.NoError(t, decoded.(codec.Serializable).UnmarshalBinary(binary))
+
+	text, err := queue.(codec.Serializable).MarshalJSON()
+	require.NoError(t, err)
+	fromJSON := ADTQueue.NewLinkedQueue[int]()
+	require.NoError(t, fromJSON.(codec.Serializable).UnmarshalJSON(text))
+
+	for i := 0; i < 100; i++ {
+		require.Equal(t, i, decoded.Dequeue())
+		require.Equal(t, i, fromJSON.Dequeue())
+	}
+	require.True(t, decoded.IsEmpty())
+	require.Equal(t, 0, queue.Peek(), "Marshalling should not modify the queue")
+}
+
+func TestQueueTryMethods(t *testing.T) {
+	queue := ADTQueue.NewLinkedQueue[int]()
+	_, err := ADTQueue.TryPeek(queue)
+	require.ErrorIs(t, err, ADTQueue.ErrEmpty)
+	_, err = ADTQueue.TryDequeue(queue)
+	require.ErrorIs(t, err, ADTQueue.ErrEmpty)
+	require.EqualError(t, err, "queue: empty queue")
+
+	queue.Enqueue(_INT1)
+	queue.Enqueue(_INT2)
+	first, err := ADTQueue.TryPeek(queue)
+	require.NoError(t, err)
+	require.Equal(t, _INT1, first)
+	first, err = ADTQueue.TryDequeue(queue)
+	require.NoError(t, err)
+	require.Equal(t, _INT1, first)
+	require.Equal(t, _INT2, queue.Peek())
+}
+
+func TestQueueConformance(t *testing.T) {
+	queuetest.RunConformance(t, ADTQueue.NewLinkedQueue[int])
+}
+
+func TestArenaQueueConformance(t *testing.T) {
+	queuetest.RunConformance(t, ADTQueue.NewArenaLinkedQueue[int])
+}
+
+func TestSynchronizedQueueConformance(t *testing.T) {
+	queuetest.RunConformance(t, func() ADTQueue.Queue[int] {
+		return ADTQueue.NewSynchronized(ADTQueue.NewLinkedQueue[int]())
+	})
+}
+
+func TestSynchronizedQueueConcurrentUse(t *testing.T) {
+	const producers, consumers, enqueues = 4, 4, 1000
+	queue := ADTQueue.NewSynchronized(ADTQueue.NewLinkedQueue[int]())
+	var producing, consuming sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producing.Add(1)
+		go func(p int) {
+			defer producing.Done()
+			for i := 0; i < enqueues; i++ {
+				queue.Enqueue(p*enqueues + i)
+			}
+		}(p)
+	}
+
+	done := make(chan struct{})
+	dequeued := make([][]int, consumers)
+	for c := 0; c < consumers; c++ {
+		consuming.Add(1)
+		go func(c int) {
+			defer consuming.Done()
+			for {
+				front, ok := queue.DequeueIfNotEmpty()
+				if ok {
+					dequeued[c] = append(dequeued[c], front)
+					continue
+				}
+				select {
+				case <-done:
+					if queue.IsEmpty() {
+						return
+					}
+				default:
+				}
+			}
+		}(c)
+	}
+	producing.Wait()
+	close(done)
+	consuming.Wait()
+
+	total := 0
+	for _, elements := range dequeued {
+		last := make(map[int]int)
+		for _, element := range elements {
+			producer := element / enqueues
+			if previous, ok := last[producer]; ok {
+				require.Less(t, previous, element, "Each consumer should see the elements of a producer in order")
+			}
+			last[producer] = element
+		}
+		total += len(elements)
+	}
+	require.Equal(t, producers*enqueues, total)
+}
+
+// BenchmarkNodeAllocation compares allocating each node on its own against allocating them from an arena,
+// while filling a queue, cycling its elements through it and emptying it.
+func BenchmarkNodeAllocation(b *testing.B) {
+	factories := map[string]func() ADTQueue.Queue[int]{
+		"Heap":  ADTQueue.NewLinkedQueue[int],
+		"Arena": ADTQueue.NewArenaLinkedQueue[int],
+	}
+	for name, create := range factories {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				queue := create()
+				for j := 0; j < _INT_VOL; j++ {
+					queue.Enqueue(j)
+				}
+				for j := 0; j < _INT_VOL; j++ {
+					queue.Enqueue(queue.Dequeue())
+				}
+				for !queue.IsEmpty() {
+					queue.Dequeue()
+				}
+			}
+		})
+	}
+}
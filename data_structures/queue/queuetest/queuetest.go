@@ -0,0 +1,149 @@
+// Package queuetest verifies that an implementation of queue.Queue behaves as the package documents, so that
+// a new implementation can be checked with a single call to RunConformance from its tests.
+package queuetest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	ADTQueue "github.com/sebagarciad/algorithms-and-data-structures/queue"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_EMPTY_QUEUE = "The queue is empty"
+	_VOLUME      = 10000
+	_RANDOM_OPS  = 5000
+)
+
+// RunConformance runs the behavioural, volume, encoding and randomized tests against the queues created by
+// factory, each of which must be empty.
+// The encoding tests are skipped for the queues that do not implement codec.Serializable.
+func RunConformance(t *testing.T, factory func() ADTQueue.Queue[int]) {
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, factory) })
+	t.Run("FirstInFirstOut", func(t *testing.T) { testFirstInFirstOut(t, factory) })
+	t.Run("Iterate", func(t *testing.T) { testIterate(t, factory) })
+	t.Run("Volume", func(t *testing.T) { testVolume(t, factory) })
+	t.Run("Encoding", func(t *testing.T) { testEncoding(t, factory) })
+	t.Run("Randomized", func(t *testing.T) { testRandomized(t, factory) })
+}
+
+func testEmpty(t *testing.T, factory func() ADTQueue.Queue[int]) {
+	queue := factory()
+	require.True(t, queue.IsEmpty())
+	require.PanicsWithValue(t, _EMPTY_QUEUE, func() { queue.Peek() })
+	require.PanicsWithValue(t, _EMPTY_QUEUE, func() { queue.Dequeue() })
+	_, err := ADTQueue.TryPeek(queue)
+	require.ErrorIs(t, err, ADTQueue.ErrEmpty)
+	_, err = ADTQueue.TryDequeue(queue)
+	require.ErrorIs(t, err, ADTQueue.ErrEmpty)
+
+	queue.Enqueue(1)
+	queue.Dequeue()
+	require.True(t, queue.IsEmpty(), "A queue emptied by dequeuing should behave as a new one")
+	require.PanicsWithValue(t, _EMPTY_QUEUE, func() { queue.Peek() })
+}
+
+func testFirstInFirstOut(t *testing.T, factory func() ADTQueue.Queue[int]) {
+	queue := factory()
+	for i := 0; i < 10; i++ {
+		queue.Enqueue(i)
+		require.Equal(t, 0, queue.Peek(), "Enqueuing should not change the front of the queue")
+	}
+	for i := 0; i < 10; i++ {
+		require.False(t, queue.IsEmpty())
+		front, err := ADTQueue.TryPeek(queue)
+		require.NoError(t, err)
+		require.Equal(t, i, front)
+		require.Equal(t, i, queue.Dequeue())
+	}
+	require.True(t, queue.IsEmpty())
+}
+
+func testIterate(t *testing.T, factory func() ADTQueue.Queue[int]) {
+	queue := factory()
+	for i := 1; i <= 5; i++ {
+		queue.Enqueue(i)
+	}
+	visited := []int{}
+	queue.Iterate(func(element int) bool {
+		visited = append(visited, element)
+		return element < 3
+	})
+	require.Equal(t, []int{1, 2, 3}, visited, "The elements should be visited from the front until visit returns false")
+	require.Equal(t, 1, queue.Peek(), "Iterating should not modify the queue")
+
+	factory().Iterate(func(int) bool {
+		require.Fail(t, "An empty queue has nothing to visit")
+		return true
+	})
+}
+
+func testVolume(t *testing.T, factory func() ADTQueue.Queue[int]) {
+	queue := factory()
+	for i := 0; i < _VOLUME; i++ {
+		queue.Enqueue(i)
+	}
+	for i := 0; i < _VOLUME; i++ {
+		require.Equal(t, i, queue.Dequeue())
+	}
+	require.True(t, queue.IsEmpty())
+}
+
+func testEncoding(t *testing.T, factory func() ADTQueue.Queue[int]) {
+	queue := factory()
+	if _, ok := queue.(codec.Serializable); !ok {
+		t.Skip("The queue does not implement codec.Serializable")
+	}
+	for i := 0; i < 100; i++ {
+		queue.Enqueue(i)
+	}
+
+	binary, err := queue.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+	fromBinary := factory()
+	fromBinary.Enqueue(-1)
+	require.NoError(t, fromBinary.(codec.Serializable).UnmarshalBinary(binary))
+
+	text, err := queue.(codec.Serializable).MarshalJSON()
+	require.NoError(t, err)
+	fromJSON := factory()
+	require.NoError(t, fromJSON.(codec.Serializable).UnmarshalJSON(text))
+
+	require.Equal(t, 0, queue.Peek(), "Marshalling should not modify the queue")
+	for i := 0; i < 100; i++ {
+		require.Equal(t, i, fromBinary.Dequeue(), "Unmarshalling should replace the contents of the queue")
+		require.Equal(t, i, fromJSON.Dequeue())
+	}
+	require.True(t, fromBinary.IsEmpty())
+	require.True(t, fromJSON.IsEmpty())
+}
+
+// testRandomized applies random operations to the queue and to a slice, and checks that both always agree.
+func testRandomized(t *testing.T, factory func() ADTQueue.Queue[int]) {
+	rng := rand.New(rand.NewSource(1))
+	queue := factory()
+	model := []int{}
+	for i := 0; i < _RANDOM_OPS; i++ {
+		switch rng.Intn(3) {
+		case 0, 1:
+			queue.Enqueue(i)
+			model = append(model, i)
+		default:
+			front, err := ADTQueue.TryDequeue(queue)
+			if len(model) == 0 {
+				require.ErrorIs(t, err, ADTQueue.ErrEmpty)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, model[0], front)
+			model = model[1:]
+		}
+		require.Equal(t, len(model) == 0, queue.IsEmpty())
+		if len(model) > 0 {
+			require.Equal(t, model[0], queue.Peek())
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package queue
+
+import (
+	"sync"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+)
+
+// SynchronizedQueue is a Queue that can be shared between goroutines. Each of its operations is atomic, and so
+// are the compound operations it adds, which would otherwise need a lock held across several calls.
+type SynchronizedQueue[T any] interface {
+	Queue[T]
+
+	// DequeueIfNotEmpty removes the first element from the queue and returns its value and true, or false if the
+	// queue is empty.
+	DequeueIfNotEmpty() (T, bool)
+
+	// Update runs update with exclusive access to the wrapped queue, so that the operations it performs on it are
+	// atomic. The queue it receives must not be kept after update returns.
+	Update(update func(Queue[T]))
+}
+
+type synchronizedQueue[T any] struct {
+	lock  sync.RWMutex
+	queue Queue[T]
+}
+
+// NewSynchronized wraps the queue in a SynchronizedQueue, which guards it with a read-write lock. The queue must
+// not be used directly afterwards.
+func NewSynchronized[T any](queue Queue[T]) SynchronizedQueue[T] {
+	return &synchronizedQueue[T]{queue: queue}
+}
+
+func (synchronized *synchronizedQueue[T]) IsEmpty() bool {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return synchronized.queue.IsEmpty()
+}
+
+func (synchronized *synchronizedQueue[T]) Peek() T {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return synchronized.queue.Peek()
+}
+
+func (synchronized *synchronizedQueue[T]) Enqueue(element T) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	synchronized.queue.Enqueue(element)
+}
+
+func (synchronized *synchronizedQueue[T]) Dequeue() T {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	return synchronized.queue.Dequeue()
+}
+
+func (synchronized *synchronizedQueue[T]) TryPeek() (T, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return TryPeek(synchronized.queue)
+}
+
+func (synchronized *synchronizedQueue[T]) TryDequeue() (T, error) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	return TryDequeue(synchronized.queue)
+}
+
+func (synchronized *synchronizedQueue[T]) DequeueIfNotEmpty() (T, bool) {
+	front, err := synchronized.TryDequeue()
+	return front, err == nil
+}
+
+// Iterate visits a snapshot of the elements taken when it is called, so visit may use the queue.
+func (synchronized *synchronizedQueue[T]) Iterate(visit func(T) bool) {
+	synchronized.lock.RLock()
+	var elements []T
+	synchronized.queue.Iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	synchronized.lock.RUnlock()
+	for _, element := range elements {
+		if !visit(element) {
+			return
+		}
+	}
+}
+
+func (synchronized *synchronizedQueue[T]) Update(update func(Queue[T])) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	update(synchronized.queue)
+}
+
+// serializable returns the wrapped queue, or codec.ErrNotSerializable if it does not implement
+// codec.Serializable.
+func (synchronized *synchronizedQueue[T]) serializable() (codec.Serializable, error) {
+	if serializable, ok := synchronized.queue.(codec.Serializable); ok {
+		return serializable, nil
+	}
+	return nil, codec.ErrNotSerializable
+}
+
+func (synchronized *synchronizedQueue[T]) MarshalBinary() ([]byte, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return nil, err
+	}
+	return serializable.MarshalBinary()
+}
+
+func (synchronized *synchronizedQueue[T]) UnmarshalBinary(data []byte) error {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return err
+	}
+	return serializable.UnmarshalBinary(data)
+}
+
+func (synchronized *synchronizedQueue[T]) MarshalJSON() ([]byte, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return nil, err
+	}
+	return serializable.MarshalJSON()
+}
+
+func (synchronized *synchronizedQueue[T]) UnmarshalJSON(data []byte) error {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return err
+	}
+	return serializable.UnmarshalJSON(data)
+}
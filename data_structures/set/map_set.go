@@ -0,0 +1,106 @@
+package set
+
+import (
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+)
+
+const (
+	_ELEMENT_NOT_FOUND = "The element does not belong to the set"
+	_NEGATIVE_COUNT    = "The number of occurrences cannot be negative"
+)
+
+// mapSet is a Set that stores its elements as the keys of a Map. The kind of map decides the kind of set,
+// and empty creates a set of the same kind for the results of the algebraic operations.
+type mapSet[T comparable] struct {
+	elements ADTMap.Map[T, struct{}]
+	empty    func() Set[T]
+}
+
+// ===================== NewHashSet ==========================
+
+// NewHashSet creates a set backed by a hash map, which traverses its elements in no particular order.
+func NewHashSet[T comparable]() Set[T] {
+	set := new(mapSet[T])
+	set.elements = ADTMap.NewHash[T, struct{}]()
+	set.empty = NewHashSet[T]
+	return set
+}
+
+// ===================== Set Primitives ==========================
+
+func (set *mapSet[T]) Add(element T) {
+	set.elements.Save(element, struct{}{})
+}
+
+func (set *mapSet[T]) Contains(element T) bool {
+	return set.elements.Contains(element)
+}
+
+func (set *mapSet[T]) Remove(element T) {
+	if !set.elements.Contains(element) {
+		panic(_ELEMENT_NOT_FOUND)
+	}
+	set.elements.Remove(element)
+}
+
+func (set *mapSet[T]) Count() int {
+	return set.elements.Count()
+}
+
+func (set *mapSet[T]) Iterate(visit func(element T) bool) {
+	set.elements.Iterate(func(element T, _ struct{}) bool {
+		return visit(element)
+	})
+}
+
+// ===================== Set Algebra ==========================
+
+func (set *mapSet[T]) Union(other Set[T]) Set[T] {
+	result := set.empty()
+	for _, source := range []Set[T]{set, other} {
+		source.Iterate(func(element T) bool {
+			result.Add(element)
+			return true
+		})
+	}
+	return result
+}
+
+// Intersection traverses the smallest of both sets, and looks its elements up in the other one.
+func (set *mapSet[T]) Intersection(other Set[T]) Set[T] {
+	result := set.empty()
+	smaller, larger := Set[T](set), other
+	if other.Count() < set.Count() {
+		smaller, larger = other, set
+	}
+	smaller.Iterate(func(element T) bool {
+		if larger.Contains(element) {
+			result.Add(element)
+		}
+		return true
+	})
+	return result
+}
+
+func (set *mapSet[T]) Difference(other Set[T]) Set[T] {
+	result := set.empty()
+	set.Iterate(func(element T) bool {
+		if !other.Contains(element) {
+			result.Add(element)
+		}
+		return true
+	})
+	return result
+}
+
+func (set *mapSet[T]) IsSubset(other Set[T]) bool {
+	if set.Count() > other.Count() {
+		return false
+	}
+	subset := true
+	set.Iterate(func(element T) bool {
+		subset = other.Contains(element)
+		return subset
+	})
+	return subset
+}
@@ -0,0 +1,153 @@
+package set
+
+import (
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+	ADTPriorityQueue "github.com/sebagarciad/algorithms-and-data-structures/priority_queue"
+)
+
+// hashMultiset stores the number of occurrences of each element in a hash map, along with their total.
+type hashMultiset[T comparable] struct {
+	counts ADTMap.Map[T, int]
+	size   int
+}
+
+// ===================== NewMultiset ==========================
+
+// NewMultiset creates a multiset backed by a hash map.
+func NewMultiset[T comparable]() Multiset[T] {
+	multiset := new(hashMultiset[T])
+	multiset.counts = ADTMap.NewHash[T, int]()
+	return multiset
+}
+
+// ===================== Multiset Primitives ==========================
+
+func (multiset *hashMultiset[T]) Add(element T) {
+	multiset.AddCount(element, 1)
+}
+
+func (multiset *hashMultiset[T]) AddCount(element T, count int) {
+	if count < 0 {
+		panic(_NEGATIVE_COUNT)
+	}
+	if count == 0 {
+		return
+	}
+	multiset.counts.Save(element, multiset.Count(element)+count)
+	multiset.size += count
+}
+
+func (multiset *hashMultiset[T]) Remove(element T) {
+	count := multiset.Count(element)
+	switch count {
+	case 0:
+		panic(_ELEMENT_NOT_FOUND)
+	case 1:
+		multiset.counts.Remove(element)
+	default:
+		multiset.counts.Save(element, count-1)
+	}
+	multiset.size--
+}
+
+func (multiset *hashMultiset[T]) RemoveAll(element T) int {
+	if !multiset.counts.Contains(element) {
+		return 0
+	}
+	count := multiset.counts.Remove(element)
+	multiset.size -= count
+	return count
+}
+
+func (multiset *hashMultiset[T]) Count(element T) int {
+	if !multiset.counts.Contains(element) {
+		return 0
+	}
+	return multiset.counts.Get(element)
+}
+
+func (multiset *hashMultiset[T]) Size() int {
+	return multiset.size
+}
+
+func (multiset *hashMultiset[T]) Distinct() int {
+	return multiset.counts.Count()
+}
+
+func (multiset *hashMultiset[T]) Iterate(visit func(element T, count int) bool) {
+	multiset.counts.Iterate(visit)
+}
+
+// ===================== Multiset Algebra ==========================
+
+// combine builds a multiset with the elements of both multisets, where each one appears as many times as
+// returned by merge given its number of occurrences in each of them.
+func (multiset *hashMultiset[T]) combine(other Multiset[T], merge func(mine, theirs int) int) Multiset[T] {
+	result := NewMultiset[T]()
+	multiset.Iterate(func(element T, count int) bool {
+		result.AddCount(element, merge(count, other.Count(element)))
+		return true
+	})
+	other.Iterate(func(element T, count int) bool {
+		if !multiset.counts.Contains(element) {
+			result.AddCount(element, merge(0, count))
+		}
+		return true
+	})
+	return result
+}
+
+func (multiset *hashMultiset[T]) Union(other Multiset[T]) Multiset[T] {
+	return multiset.combine(other, func(mine, theirs int) int { return max(mine, theirs) })
+}
+
+func (multiset *hashMultiset[T]) Sum(other Multiset[T]) Multiset[T] {
+	return multiset.combine(other, func(mine, theirs int) int { return mine + theirs })
+}
+
+func (multiset *hashMultiset[T]) Intersection(other Multiset[T]) Multiset[T] {
+	return multiset.combine(other, func(mine, theirs int) int { return min(mine, theirs) })
+}
+
+func (multiset *hashMultiset[T]) Difference(other Multiset[T]) Multiset[T] {
+	return multiset.combine(other, func(mine, theirs int) int { return max(mine-theirs, 0) })
+}
+
+func (multiset *hashMultiset[T]) IsSubset(other Multiset[T]) bool {
+	if multiset.Size() > other.Size() {
+		return false
+	}
+	subset := true
+	multiset.Iterate(func(element T, count int) bool {
+		subset = count <= other.Count(element)
+		return subset
+	})
+	return subset
+}
+
+// ===================== MostCommon() ==========================
+
+// MostCommon keeps the n most frequent elements seen so far in a heap whose top is the least frequent of
+// them, so it takes O(m log n) for m different elements.
+func (multiset *hashMultiset[T]) MostCommon(n int) []Occurrence[T] {
+	if n <= 0 {
+		return []Occurrence[T]{}
+	}
+	leastFrequentFirst := func(a, b Occurrence[T]) int { return b.Count - a.Count }
+	heap := ADTPriorityQueue.NewHeap(leastFrequentFirst)
+	multiset.Iterate(func(element T, count int) bool {
+		if heap.Size() < n {
+			heap.Enqueue(Occurrence[T]{element, count})
+		} else if heap.PeekMax().Count < count {
+			heap.Dequeue()
+			heap.Enqueue(Occurrence[T]{element, count})
+		}
+		return true
+	})
+
+	result := make([]Occurrence[T], heap.Size())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Dequeue()
+	}
+	return result
+}
@@ -0,0 +1,30 @@
+package set
+
+import (
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+)
+
+type orderedSet[T comparable] struct {
+	mapSet[T]
+	tree ADTMap.BSTMap[T, struct{}]
+}
+
+// ===================== CreateOrderedSet ==========================
+
+// CreateOrderedSet creates a set backed by a binary search tree, which traverses its elements in the order
+// given by cmpFunc.
+func CreateOrderedSet[T comparable](cmpFunc func(T, T) int) OrderedSet[T] {
+	set := new(orderedSet[T])
+	set.tree = ADTMap.CreateBST[T, struct{}](cmpFunc)
+	set.elements = set.tree
+	set.empty = func() Set[T] { return CreateOrderedSet(cmpFunc) }
+	return set
+}
+
+// =================== Internal Iterator ===================
+
+func (set *orderedSet[T]) IterateRange(from *T, to *T, visit func(element T) bool) {
+	set.tree.IterateRange(from, to, func(element T, _ struct{}) bool {
+		return visit(element)
+	})
+}
@@ -0,0 +1,102 @@
+package set
+
+type Set[T comparable] interface {
+	// Add adds the element to the set. If it already belongs to the set, nothing changes.
+	Add(element T)
+
+	// Contains returns true if the element belongs to the set, false otherwise.
+	Contains(element T) bool
+
+	// Remove removes the element from the set. If it does not belong to the set, it panics with the message
+	// "The element does not belong to the set".
+	Remove(element T)
+
+	// Count returns the number of elements in the set.
+	Count() int
+
+	// Iterate traverses the elements of the set and executes the "visit" function on each of them.
+	// If "visit" returns false, the iteration stops.
+	Iterate(visit func(element T) bool)
+
+	// Union returns a new set with the elements that belong to this set or to the other one.
+	// The result is a set of the same kind as this one.
+	Union(other Set[T]) Set[T]
+
+	// Intersection returns a new set with the elements that belong to both sets.
+	// The result is a set of the same kind as this one.
+	Intersection(other Set[T]) Set[T]
+
+	// Difference returns a new set with the elements of this set that do not belong to the other one.
+	// The result is a set of the same kind as this one.
+	Difference(other Set[T]) Set[T]
+
+	// IsSubset returns true if every element of this set belongs to the other one.
+	IsSubset(other Set[T]) bool
+}
+
+// OrderedSet is a Set whose elements are traversed in increasing order.
+type OrderedSet[T comparable] interface {
+	Set[T]
+
+	// IterateRange traverses in order the elements between from and to, both included, executing the "visit"
+	// function on each of them. A nil bound leaves that end of the range open.
+	IterateRange(from *T, to *T, visit func(element T) bool)
+}
+
+// Multiset is a collection where each element may appear several times, also known as a bag.
+type Multiset[T comparable] interface {
+	// Add adds one occurrence of the element.
+	Add(element T)
+
+	// AddCount adds the given number of occurrences of the element. If the number is negative, it panics
+	// with the message "The number of occurrences cannot be negative".
+	AddCount(element T, count int)
+
+	// Remove removes one occurrence of the element. If it does not belong to the multiset, it panics with
+	// the message "The element does not belong to the set".
+	Remove(element T)
+
+	// RemoveAll removes every occurrence of the element and returns how many there were.
+	RemoveAll(element T) int
+
+	// Count returns the number of occurrences of the element, 0 if it does not belong to the multiset.
+	Count(element T) int
+
+	// Size returns the total number of occurrences in the multiset.
+	Size() int
+
+	// Distinct returns the number of different elements in the multiset.
+	Distinct() int
+
+	// Iterate traverses the different elements of the multiset together with their number of occurrences,
+	// executing the "visit" function on each of them. If "visit" returns false, the iteration stops.
+	Iterate(visit func(element T, count int) bool)
+
+	// Union returns a new multiset where each element appears as many times as in the multiset where it
+	// appears the most.
+	Union(other Multiset[T]) Multiset[T]
+
+	// Sum returns a new multiset with the occurrences of both multisets added.
+	Sum(other Multiset[T]) Multiset[T]
+
+	// Intersection returns a new multiset where each element appears as many times as in the multiset where
+	// it appears the least.
+	Intersection(other Multiset[T]) Multiset[T]
+
+	// Difference returns a new multiset with the occurrences of this multiset that are not matched by
+	// occurrences in the other one.
+	Difference(other Multiset[T]) Multiset[T]
+
+	// IsSubset returns true if no element appears more times in this multiset than in the other one.
+	IsSubset(other Multiset[T]) bool
+
+	// MostCommon returns up to n elements with the most occurrences, sorted from the most to the least
+	// frequent. Elements with the same number of occurrences are returned in no particular order.
+	MostCommon(n int) []Occurrence[T]
+}
+
+// Occurrence is an element of a Multiset together with its number of occurrences.
+type Occurrence[T comparable] struct {
+	Element T
+	Count   int
+}
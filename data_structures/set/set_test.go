@@ -0,0 +1,181 @@
+package set_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	ADTSet "github.com/sebagarciad/algorithms-and-data-structures/set"
+
+	"github.com/stretchr/testify/require"
+)
+
+func cmpInt(a, b int) int {
+	return a - b
+}
+
+func setFactories() map[string]func() ADTSet.Set[int] {
+	return map[string]func() ADTSet.Set[int]{
+		"Hash":    ADTSet.NewHashSet[int],
+		"Ordered": func() ADTSet.Set[int] { return ADTSet.CreateOrderedSet(cmpInt) },
+	}
+}
+
+func sortedElements(set ADTSet.Set[int]) []int {
+	elements := []int{}
+	set.Iterate(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	sort.Ints(elements)
+	return elements
+}
+
+func setOf(factory func() ADTSet.Set[int], elements ...int) ADTSet.Set[int] {
+	set := factory()
+	for _, element := range elements {
+		set.Add(element)
+	}
+	return set
+}
+
+func TestSetBasics(t *testing.T) {
+	for name, factory := range setFactories() {
+		t.Run(name, func(t *testing.T) {
+			set := factory()
+			require.EqualValues(t, 0, set.Count())
+			require.PanicsWithValue(t, "The element does not belong to the set", func() { set.Remove(1) })
+
+			set.Add(3)
+			set.Add(1)
+			set.Add(3)
+			require.EqualValues(t, 2, set.Count())
+			require.True(t, set.Contains(3))
+			require.False(t, set.Contains(2))
+
+			set.Remove(3)
+			require.False(t, set.Contains(3))
+			require.Equal(t, []int{1}, sortedElements(set))
+		})
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	for name, factory := range setFactories() {
+		t.Run(name, func(t *testing.T) {
+			a := setOf(factory, 1, 2, 3, 4)
+			b := setOf(ADTSet.NewHashSet[int], 3, 4, 5)
+
+			require.Equal(t, []int{1, 2, 3, 4, 5}, sortedElements(a.Union(b)))
+			require.Equal(t, []int{3, 4}, sortedElements(a.Intersection(b)))
+			require.Equal(t, []int{1, 2}, sortedElements(a.Difference(b)))
+			require.Equal(t, []int{5}, sortedElements(b.Difference(a)))
+			require.False(t, a.IsSubset(b))
+			require.True(t, a.Intersection(b).IsSubset(b))
+			require.True(t, factory().IsSubset(a), "The empty set is a subset of every set")
+			require.Equal(t, []int{1, 2, 3, 4}, sortedElements(a), "The operations should not modify the sets")
+
+			_, ordered := a.Union(b).(ADTSet.OrderedSet[int])
+			_, receiverOrdered := a.(ADTSet.OrderedSet[int])
+			require.Equal(t, receiverOrdered, ordered, "The result should be of the same kind as the receiver")
+		})
+	}
+}
+
+func TestOrderedSet(t *testing.T) {
+	set := ADTSet.CreateOrderedSet(cmpInt)
+	for _, element := range []int{5, 1, 4, 2, 3} {
+		set.Add(element)
+	}
+
+	elements := []int{}
+	set.Iterate(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	require.Equal(t, []int{1, 2, 3, 4, 5}, elements)
+
+	from, to := 2, 4
+	elements = []int{}
+	set.IterateRange(&from, &to, func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	require.Equal(t, []int{2, 3, 4}, elements)
+}
+
+func TestMultiset(t *testing.T) {
+	multiset := ADTSet.NewMultiset[string]()
+	require.EqualValues(t, 0, multiset.Count("a"))
+	require.PanicsWithValue(t, "The element does not belong to the set", func() { multiset.Remove("a") })
+	require.PanicsWithValue(t, "The number of occurrences cannot be negative", func() { multiset.AddCount("a", -1) })
+
+	multiset.Add("a")
+	multiset.Add("a")
+	multiset.AddCount("b", 3)
+	multiset.AddCount("c", 0)
+	require.EqualValues(t, 2, multiset.Count("a"))
+	require.EqualValues(t, 5, multiset.Size())
+	require.EqualValues(t, 2, multiset.Distinct())
+
+	multiset.Remove("a")
+	require.EqualValues(t, 1, multiset.Count("a"))
+	multiset.Remove("a")
+	require.EqualValues(t, 1, multiset.Distinct())
+	require.EqualValues(t, 3, multiset.RemoveAll("b"))
+	require.EqualValues(t, 0, multiset.RemoveAll("b"))
+	require.EqualValues(t, 0, multiset.Size())
+}
+
+func TestMultisetAlgebra(t *testing.T) {
+	a, b := ADTSet.NewMultiset[string](), ADTSet.NewMultiset[string]()
+	a.AddCount("x", 3)
+	a.AddCount("y", 1)
+	b.AddCount("x", 1)
+	b.AddCount("y", 2)
+	b.AddCount("z", 1)
+
+	counts := func(multiset ADTSet.Multiset[string]) map[string]int {
+		result := make(map[string]int)
+		multiset.Iterate(func(element string, count int) bool {
+			result[element] = count
+			return true
+		})
+		return result
+	}
+
+	require.Equal(t, map[string]int{"x": 3, "y": 2, "z": 1}, counts(a.Union(b)))
+	require.Equal(t, map[string]int{"x": 4, "y": 3, "z": 1}, counts(a.Sum(b)))
+	require.Equal(t, map[string]int{"x": 1, "y": 1}, counts(a.Intersection(b)))
+	require.Equal(t, map[string]int{"x": 2}, counts(a.Difference(b)))
+	require.Equal(t, map[string]int{"y": 1, "z": 1}, counts(b.Difference(a)))
+	require.False(t, a.IsSubset(b))
+	require.True(t, a.Intersection(b).IsSubset(a))
+	require.EqualValues(t, 6, a.Union(b).Size())
+}
+
+func TestMultisetMostCommon(t *testing.T) {
+	multiset := ADTSet.NewMultiset[int]()
+	rng := rand.New(rand.NewSource(13))
+	expected := make(map[int]int)
+	for i := 0; i < 100; i++ {
+		count := rng.Intn(1000) + 1
+		multiset.AddCount(i, count)
+		expected[i] = count
+	}
+
+	counts := []int{}
+	for _, count := range expected {
+		counts = append(counts, count)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(counts)))
+
+	top := multiset.MostCommon(10)
+	require.Len(t, top, 10)
+	for i, occurrence := range top {
+		require.Equal(t, expected[occurrence.Element], occurrence.Count)
+		require.Equal(t, counts[i], occurrence.Count, "The elements should be sorted by number of occurrences")
+	}
+	require.Len(t, multiset.MostCommon(1000), 100)
+	require.Empty(t, multiset.MostCommon(0))
+}
@@ -50,6 +50,14 @@ func (stack *dynamicStack[T]) Pop() T {
 	return top
 }
 
+func (stack *dynamicStack[T]) Iterate(visit func(T) bool) {
+	for _, element := range stack.data[:stack.count] {
+		if !visit(element) {
+			return
+		}
+	}
+}
+
 // Creates a new slice and copies the elements from the previous slice to it. If the new capacity falls below the
 // initial capacity, the initial capacity is restored.
 func (stack *dynamicStack[T]) resize(newCap int) {
@@ -0,0 +1,60 @@
+package stack
+
+import (
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+)
+
+// Stacks implement codec.Serializable: they are encoded from the bottom to the top, and rebuilt by pushing
+// the elements back in that order. The binary form uses the default codec of the elements, and MarshalWith
+// and UnmarshalWith encode any stack with a given one.
+
+// MarshalWith encodes the elements of the stack in binary, from the bottom to the top, with the given codec.
+func MarshalWith[T any](stack Stack[T], elements codec.Codec[T]) ([]byte, error) {
+	return codec.Slice(elements).Append(nil, codec.Collect(stack.Iterate))
+}
+
+// UnmarshalWith replaces the contents of the stack with the elements encoded by MarshalWith with the same codec.
+// If the data is not valid, the stack is left untouched.
+func UnmarshalWith[T any](stack Stack[T], data []byte, elements codec.Codec[T]) error {
+	decoded := []T{}
+	if err := codec.UnmarshalSequence(data, elements, func(element T) { decoded = append(decoded, element) }); err != nil {
+		return err
+	}
+	replaceContents(stack, decoded)
+	return nil
+}
+
+// replaceContents empties the stack and pushes the elements, all at once if the stack is synchronized.
+func replaceContents[T any](stack Stack[T], elements []T) {
+	if synchronized, isSynchronized := stack.(SynchronizedStack[T]); isSynchronized {
+		synchronized.Update(func(stack Stack[T]) { replaceContents(stack, elements) })
+		return
+	}
+	for !stack.IsEmpty() {
+		stack.Pop()
+	}
+	for _, element := range elements {
+		stack.Push(element)
+	}
+}
+
+func (stack *dynamicStack[T]) MarshalBinary() ([]byte, error) {
+	return MarshalWith[T](stack, codec.Default[T]())
+}
+
+func (stack *dynamicStack[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSequenceJSON(stack.count, stack.Iterate)
+}
+
+func (stack *dynamicStack[T]) UnmarshalBinary(data []byte) error {
+	return UnmarshalWith[T](stack, data, codec.Default[T]())
+}
+
+func (stack *dynamicStack[T]) UnmarshalJSON(data []byte) error {
+	decoded := []T{}
+	if err := codec.UnmarshalSequenceJSON(data, func(element T) { decoded = append(decoded, element) }); err != nil {
+		return err
+	}
+	replaceContents[T](stack, decoded)
+	return nil
+}
@@ -1,5 +1,10 @@
 package stack
 
+import "errors"
+
+// ErrEmpty is returned by TryPeek and TryPop when the stack is empty.
+var ErrEmpty = errors.New("stack: empty stack")
+
 type Stack[T any] interface {
 
 	// IsEmpty returns true if the stack has no elements, false otherwise.
@@ -15,4 +20,34 @@ type Stack[T any] interface {
 	// Pop removes the top element from the stack. If the stack has elements, it removes and returns the top value.
 	// If it is empty, it panics with the message "The stack is empty".
 	Pop() T
+
+	// Iterate traverses the elements from the bottom to the top of the stack, executing the "visit" function on
+	// each of them, so that pushing them in that order rebuilds the stack. If "visit" returns false, the
+	// iteration stops.
+	Iterate(visit func(T) bool)
+}
+
+// TryPeek returns the value at the top of the stack, or ErrEmpty if it is empty. Like TryPop, it calls the
+// method of the same name if the stack has one, which the synchronized stacks implement atomically.
+func TryPeek[T any](stack Stack[T]) (T, error) {
+	if stack, ok := stack.(interface{ TryPeek() (T, error) }); ok {
+		return stack.TryPeek()
+	}
+	if stack.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return stack.Peek(), nil
+}
+
+// TryPop removes the top element from the stack and returns its value, or ErrEmpty if it is empty.
+func TryPop[T any](stack Stack[T]) (T, error) {
+	if stack, ok := stack.(interface{ TryPop() (T, error) }); ok {
+		return stack.TryPop()
+	}
+	if stack.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return stack.Pop(), nil
 }
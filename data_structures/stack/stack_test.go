@@ -1,9 +1,12 @@
 package stack_test
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
 	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+	"github.com/sebagarciad/algorithms-and-data-structures/stack/stacktest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -223,3 +226,164 @@ func TestPop(t *testing.T) {
 	require.Equal(t, _FLOAT1, stackFloat.Pop(), "Should return the float 9.21564")
 	require.True(t, stackFloat.IsEmpty(), "After popping all elements, IsEmpty should return True")
 }
+
+func TestStackEncoding(t *testing.T) {
+	stack := ADTStack.NewStack[string]()
+	for _, element := range []string{"a", "b", "c"} {
+		stack.Push(element)
+	}
+
+	binary, err := stack.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+	decoded := ADTStack.NewStack[string]()
+	require.NoError(t, decoded.(codec.Serializable).UnmarshalBinary(binary))
+
+	text, err := stack.(codec.Serializable).MarshalJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `["a","b","c"]`, string(text), "The elements should be encoded from the bottom to the top")
+	fromJSON := ADTStack.NewStack[string]()
+	require.NoError(t, fromJSON.(codec.Serializable).UnmarshalJSON(text))
+
+	for _, expected := range []string{"c", "b", "a"} {
+		require.Equal(t, expected, decoded.Pop())
+		require.Equal(t, expected, fromJSON.Pop())
+	}
+	require.True(t, decoded.IsEmpty())
+	require.Equal(t, "c", stack.Peek(), "Marshalling should not modify the stack")
+}
+
+func TestStackTryMethods(t *testing.T) {
+	stack := ADTStack.NewStack[int]()
+	_, err := ADTStack.TryPeek(stack)
+	require.ErrorIs(t, err, ADTStack.ErrEmpty)
+	_, err = ADTStack.TryPop(stack)
+	require.ErrorIs(t, err, ADTStack.ErrEmpty)
+	require.EqualError(t, err, "stack: empty stack")
+
+	stack.Push(_INT1)
+	top, err := ADTStack.TryPeek(stack)
+	require.NoError(t, err)
+	require.Equal(t, _INT1, top)
+	top, err = ADTStack.TryPop(stack)
+	require.NoError(t, err)
+	require.Equal(t, _INT1, top)
+	require.True(t, stack.IsEmpty())
+}
+
+func TestStackConformance(t *testing.T) {
+	stacktest.RunConformance(t, ADTStack.NewStack[int])
+}
+
+func TestSynchronizedStackConformance(t *testing.T) {
+	stacktest.RunConformance(t, func() ADTStack.Stack[int] {
+		return ADTStack.NewSynchronized(ADTStack.NewStack[int]())
+	})
+}
+
+func TestSynchronizedStackConcurrentUse(t *testing.T) {
+	const goroutines, pushes = 8, 1000
+	stack := ADTStack.NewSynchronized(ADTStack.NewStack[int]())
+	var group sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		group.Add(1)
+		go func(g int) {
+			defer group.Done()
+			for i := 0; i < pushes; i++ {
+				stack.Push(g*pushes + i)
+			}
+		}(g)
+	}
+	group.Wait()
+
+	popped := make([][]int, goroutines)
+	for g := 0; g < goroutines; g++ {
+		group.Add(1)
+		go func(g int) {
+			defer group.Done()
+			for top, ok := stack.PopIfNotEmpty(); ok; top, ok = stack.PopIfNotEmpty() {
+				popped[g] = append(popped[g], top)
+			}
+		}(g)
+	}
+	group.Wait()
+
+	seen := make(map[int]bool)
+	for _, elements := range popped {
+		for _, element := range elements {
+			require.False(t, seen[element], "Each element should be popped once")
+			seen[element] = true
+		}
+	}
+	require.Len(t, seen, goroutines*pushes)
+	require.True(t, stack.IsEmpty())
+	_, ok := stack.PopIfNotEmpty()
+	require.False(t, ok)
+}
+
+func TestSynchronizedStackUpdate(t *testing.T) {
+	stack := ADTStack.NewSynchronized(ADTStack.NewStack[int]())
+	var group sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for i := 0; i < 100; i++ {
+				stack.Update(func(inner ADTStack.Stack[int]) {
+					inner.Push(i)
+					inner.Push(-i)
+				})
+			}
+		}()
+	}
+	group.Wait()
+
+	for !stack.IsEmpty() {
+		negated, element := stack.Pop(), stack.Pop()
+		require.Equal(t, -element, negated, "The pushes of an update should not be interleaved with others")
+	}
+}
+
+// plainStack hides the encoding methods of the stack it wraps, as an implementation that is not serializable.
+type plainStack struct {
+	ADTStack.Stack[int]
+}
+
+func TestSynchronizedStackOfNonSerializableStack(t *testing.T) {
+	stack := ADTStack.NewSynchronized[int](plainStack{ADTStack.NewStack[int]()})
+	stack.Push(1)
+	serializable, ok := stack.(codec.Serializable)
+	require.True(t, ok, "The synchronized stack should implement codec.Serializable whatever stack it wraps")
+	_, err := serializable.MarshalBinary()
+	require.ErrorIs(t, err, codec.ErrNotSerializable)
+	_, err = serializable.MarshalJSON()
+	require.ErrorIs(t, err, codec.ErrNotSerializable)
+	require.ErrorIs(t, serializable.UnmarshalBinary(nil), codec.ErrNotSerializable)
+	require.Equal(t, 1, stack.Pop(), "A failed unmarshal should not modify the stack")
+
+	stacktest.RunConformance(t, func() ADTStack.Stack[int] { return plainStack{ADTStack.NewStack[int]()} })
+}
+
+func TestStackEncodingWithCodec(t *testing.T) {
+	elements := codec.JSON[int]()
+	for name, stack := range map[string]ADTStack.Stack[int]{
+		"Dynamic":      ADTStack.NewStack[int](),
+		"Synchronized": ADTStack.NewSynchronized(ADTStack.NewStack[int]()),
+		"Plain":        plainStack{ADTStack.NewStack[int]()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				stack.Push(i)
+			}
+			binary, err := ADTStack.MarshalWith(stack, elements)
+			require.NoError(t, err)
+
+			stack.Push(100)
+			require.NoError(t, ADTStack.UnmarshalWith(stack, binary, elements))
+			require.ErrorIs(t, ADTStack.UnmarshalWith(stack, binary[:len(binary)-1], elements), codec.ErrTruncated)
+			for i := 9; i >= 0; i-- {
+				require.Equal(t, i, stack.Pop())
+			}
+			require.True(t, stack.IsEmpty(), "Unmarshalling should replace the contents of the stack")
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Package stacktest verifies that an implementation of stack.Stack behaves as the package documents, so that
+// a new implementation can be checked with a single call to RunConformance from its tests.
+package stacktest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+	ADTStack "github.com/sebagarciad/algorithms-and-data-structures/stack"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_EMPTY_STACK = "The stack is empty"
+	_VOLUME      = 10000
+	_RANDOM_OPS  = 5000
+)
+
+// RunConformance runs the behavioural, volume, encoding and randomized tests against the stacks created by
+// factory, each of which must be empty.
+// The encoding tests are skipped for the stacks that do not implement codec.Serializable.
+func RunConformance(t *testing.T, factory func() ADTStack.Stack[int]) {
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, factory) })
+	t.Run("LastInFirstOut", func(t *testing.T) { testLastInFirstOut(t, factory) })
+	t.Run("Iterate", func(t *testing.T) { testIterate(t, factory) })
+	t.Run("Volume", func(t *testing.T) { testVolume(t, factory) })
+	t.Run("Encoding", func(t *testing.T) { testEncoding(t, factory) })
+	t.Run("Randomized", func(t *testing.T) { testRandomized(t, factory) })
+}
+
+func testEmpty(t *testing.T, factory func() ADTStack.Stack[int]) {
+	stack := factory()
+	require.True(t, stack.IsEmpty())
+	require.PanicsWithValue(t, _EMPTY_STACK, func() { stack.Peek() })
+	require.PanicsWithValue(t, _EMPTY_STACK, func() { stack.Pop() })
+	_, err := ADTStack.TryPeek(stack)
+	require.ErrorIs(t, err, ADTStack.ErrEmpty)
+	_, err = ADTStack.TryPop(stack)
+	require.ErrorIs(t, err, ADTStack.ErrEmpty)
+
+	stack.Push(1)
+	stack.Pop()
+	require.True(t, stack.IsEmpty(), "A stack emptied by popping should behave as a new one")
+	require.PanicsWithValue(t, _EMPTY_STACK, func() { stack.Peek() })
+}
+
+func testLastInFirstOut(t *testing.T, factory func() ADTStack.Stack[int]) {
+	stack := factory()
+	for i := 0; i < 10; i++ {
+		stack.Push(i)
+		require.Equal(t, i, stack.Peek())
+	}
+	for i := 9; i >= 0; i-- {
+		require.False(t, stack.IsEmpty())
+		top, err := ADTStack.TryPeek(stack)
+		require.NoError(t, err)
+		require.Equal(t, i, top)
+		require.Equal(t, i, stack.Pop())
+	}
+	require.True(t, stack.IsEmpty())
+}
+
+func testIterate(t *testing.T, factory func() ADTStack.Stack[int]) {
+	stack := factory()
+	for i := 1; i <= 5; i++ {
+		stack.Push(i)
+	}
+	visited := []int{}
+	stack.Iterate(func(element int) bool {
+		visited = append(visited, element)
+		return element < 3
+	})
+	require.Equal(t, []int{1, 2, 3}, visited, "The elements should be visited from the bottom to the top until visit returns false")
+	require.Equal(t, 5, stack.Peek(), "Iterating should not modify the stack")
+
+	factory().Iterate(func(int) bool {
+		require.Fail(t, "An empty stack has nothing to visit")
+		return true
+	})
+}
+
+func testVolume(t *testing.T, factory func() ADTStack.Stack[int]) {
+	stack := factory()
+	for i := 0; i < _VOLUME; i++ {
+		stack.Push(i)
+	}
+	for i := _VOLUME - 1; i >= 0; i-- {
+		require.Equal(t, i, stack.Pop())
+	}
+	require.True(t, stack.IsEmpty())
+}
+
+func testEncoding(t *testing.T, factory func() ADTStack.Stack[int]) {
+	stack := factory()
+	if _, ok := stack.(codec.Serializable); !ok {
+		t.Skip("The stack does not implement codec.Serializable")
+	}
+	for i := 0; i < 100; i++ {
+		stack.Push(i)
+	}
+
+	binary, err := stack.(codec.Serializable).MarshalBinary()
+	require.NoError(t, err)
+	fromBinary := factory()
+	fromBinary.Push(-1)
+	require.NoError(t, fromBinary.(codec.Serializable).UnmarshalBinary(binary))
+
+	text, err := stack.(codec.Serializable).MarshalJSON()
+	require.NoError(t, err)
+	fromJSON := factory()
+	require.NoError(t, fromJSON.(codec.Serializable).UnmarshalJSON(text))
+
+	require.Equal(t, 99, stack.Peek(), "Marshalling should not modify the stack")
+	for i := 99; i >= 0; i-- {
+		require.Equal(t, i, fromBinary.Pop())
+		require.Equal(t, i, fromJSON.Pop())
+	}
+	require.True(t, fromBinary.IsEmpty(), "Unmarshalling should replace the contents of the stack")
+	require.True(t, fromJSON.IsEmpty())
+}
+
+// testRandomized applies random operations to the stack and to a slice, and checks that both always agree.
+func testRandomized(t *testing.T, factory func() ADTStack.Stack[int]) {
+	rng := rand.New(rand.NewSource(1))
+	stack := factory()
+	model := []int{}
+	for i := 0; i < _RANDOM_OPS; i++ {
+		switch rng.Intn(3) {
+		case 0, 1:
+			stack.Push(i)
+			model = append(model, i)
+		default:
+			top, err := ADTStack.TryPop(stack)
+			if len(model) == 0 {
+				require.ErrorIs(t, err, ADTStack.ErrEmpty)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, model[len(model)-1], top)
+			model = model[:len(model)-1]
+		}
+		require.Equal(t, len(model) == 0, stack.IsEmpty())
+		if len(model) > 0 {
+			require.Equal(t, model[len(model)-1], stack.Peek())
+		}
+	}
+}
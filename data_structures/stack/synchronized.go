@@ -0,0 +1,144 @@
+package stack
+
+import (
+	"sync"
+
+	"github.com/sebagarciad/algorithms-and-data-structures/codec"
+)
+
+// SynchronizedStack is a Stack that can be shared between goroutines. Each of its operations is atomic, and so
+// are the compound operations it adds, which would otherwise need a lock held across several calls.
+type SynchronizedStack[T any] interface {
+	Stack[T]
+
+	// PopIfNotEmpty removes the top element from the stack and returns its value and true, or false if the stack
+	// is empty.
+	PopIfNotEmpty() (T, bool)
+
+	// Update runs update with exclusive access to the wrapped stack, so that the operations it performs on it are
+	// atomic. The stack it receives must not be kept after update returns.
+	Update(update func(Stack[T]))
+}
+
+type synchronizedStack[T any] struct {
+	lock  sync.RWMutex
+	stack Stack[T]
+}
+
+// NewSynchronized wraps the stack in a SynchronizedStack, which guards it with a read-write lock. The stack must
+// not be used directly afterwards.
+func NewSynchronized[T any](stack Stack[T]) SynchronizedStack[T] {
+	return &synchronizedStack[T]{stack: stack}
+}
+
+func (synchronized *synchronizedStack[T]) IsEmpty() bool {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return synchronized.stack.IsEmpty()
+}
+
+func (synchronized *synchronizedStack[T]) Peek() T {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return synchronized.stack.Peek()
+}
+
+func (synchronized *synchronizedStack[T]) Push(element T) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	synchronized.stack.Push(element)
+}
+
+func (synchronized *synchronizedStack[T]) Pop() T {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	return synchronized.stack.Pop()
+}
+
+func (synchronized *synchronizedStack[T]) TryPeek() (T, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	return TryPeek(synchronized.stack)
+}
+
+func (synchronized *synchronizedStack[T]) TryPop() (T, error) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	return TryPop(synchronized.stack)
+}
+
+func (synchronized *synchronizedStack[T]) PopIfNotEmpty() (T, bool) {
+	top, err := synchronized.TryPop()
+	return top, err == nil
+}
+
+// Iterate visits a snapshot of the elements taken when it is called, so visit may use the stack.
+func (synchronized *synchronizedStack[T]) Iterate(visit func(T) bool) {
+	synchronized.lock.RLock()
+	var elements []T
+	synchronized.stack.Iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	synchronized.lock.RUnlock()
+	for _, element := range elements {
+		if !visit(element) {
+			return
+		}
+	}
+}
+
+func (synchronized *synchronizedStack[T]) Update(update func(Stack[T])) {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	update(synchronized.stack)
+}
+
+// serializable returns the wrapped stack, or codec.ErrNotSerializable if it does not implement
+// codec.Serializable.
+func (synchronized *synchronizedStack[T]) serializable() (codec.Serializable, error) {
+	if serializable, ok := synchronized.stack.(codec.Serializable); ok {
+		return serializable, nil
+	}
+	return nil, codec.ErrNotSerializable
+}
+
+func (synchronized *synchronizedStack[T]) MarshalBinary() ([]byte, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return nil, err
+	}
+	return serializable.MarshalBinary()
+}
+
+func (synchronized *synchronizedStack[T]) UnmarshalBinary(data []byte) error {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return err
+	}
+	return serializable.UnmarshalBinary(data)
+}
+
+func (synchronized *synchronizedStack[T]) MarshalJSON() ([]byte, error) {
+	synchronized.lock.RLock()
+	defer synchronized.lock.RUnlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return nil, err
+	}
+	return serializable.MarshalJSON()
+}
+
+func (synchronized *synchronizedStack[T]) UnmarshalJSON(data []byte) error {
+	synchronized.lock.Lock()
+	defer synchronized.lock.Unlock()
+	serializable, err := synchronized.serializable()
+	if err != nil {
+		return err
+	}
+	return serializable.UnmarshalJSON(data)
+}
@@ -2,8 +2,8 @@ package comandos
 
 import (
 	"bufio"
-	ADTMap "data_structures/map"
 	"fmt"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
 	"os"
 	"strings"
 	"time"
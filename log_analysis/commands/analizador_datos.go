@@ -1,8 +1,8 @@
 package comandos
 
 import (
-	ADTMap "data_structures/map"
-	ADTHeap "data_structures/priority_queue"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
+	ADTHeap "github.com/sebagarciad/algorithms-and-data-structures/priority_queue"
 	"strconv"
 	"strings"
 	"time"
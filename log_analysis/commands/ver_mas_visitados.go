@@ -1,8 +1,8 @@
 package comandos
 
 import (
-	ADTHeap "data_structures/priority_queue"
 	"fmt"
+	ADTHeap "github.com/sebagarciad/algorithms-and-data-structures/priority_queue"
 )
 
 const (
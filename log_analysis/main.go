@@ -2,8 +2,8 @@ package main
 
 import (
 	"bufio"
-	ADTMap "data_structures/map"
 	"fmt"
+	ADTMap "github.com/sebagarciad/algorithms-and-data-structures/map"
 	commands "log_analysis/commands"
 	"os"
 	"strconv"